@@ -0,0 +1,19 @@
+package gosatnogs
+
+import "context"
+
+// TelemetryAPI is the telemetry subset of Client. Code that only reads
+// telemetry can depend on it instead of *Client so tests can substitute a
+// fake, such as the one in the satnogstest package.
+type TelemetryAPI interface {
+	GetTelemetry(satelliteID string) ([]Telemetry, error)
+	GetTelemetryContext(ctx context.Context, satelliteID string) ([]Telemetry, error)
+	GetTelemetryResponse(satelliteID string) (*TelemetryResponse, error)
+	GetTelemetryResponseContext(ctx context.Context, satelliteID string) (*TelemetryResponse, error)
+	GetTelemetryResponseNextPage(t *TelemetryResponse) (*TelemetryResponse, error)
+	GetTelemetryResponseNextPageContext(ctx context.Context, t *TelemetryResponse) (*TelemetryResponse, error)
+	GetTelemetryResponsePrevPage(t *TelemetryResponse) (*TelemetryResponse, error)
+	GetTelemetryResponsePrevPageContext(ctx context.Context, t *TelemetryResponse) (*TelemetryResponse, error)
+}
+
+var _ TelemetryAPI = (*Client)(nil)
@@ -0,0 +1,46 @@
+package gosatnogs
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SetAPIKey replaces the API key used for subsequent requests, e.g. after a
+// token rotation. It is safe to call while other goroutines are making
+// requests; requests already sent keep the key they were sent with. The key
+// is normalized as in New and rejected if malformed.
+func (c *Client) SetAPIKey(apiKey string) error {
+	key, err := normalizeAPIKey(apiKey)
+	if err != nil {
+		return err
+	}
+	c.keyMu.Lock()
+	defer c.keyMu.Unlock()
+	c.apiKey = key
+	return nil
+}
+
+// key returns the current API key.
+func (c *Client) key() string {
+	c.keyMu.RLock()
+	defer c.keyMu.RUnlock()
+	return c.apiKey
+}
+
+// normalizeAPIKey fixes the usual copy-paste mistakes in an API key:
+// surrounding whitespace and an included "Token " prefix are removed. It
+// rejects keys that still contain whitespace or control characters. No
+// request is made, so a well-formed but wrong key is only caught by the API.
+func normalizeAPIKey(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if len(key) > len("Token ") && strings.EqualFold(key[:len("Token ")], "Token ") {
+		key = strings.TrimSpace(key[len("Token "):])
+	}
+	for _, r := range key {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return "", fmt.Errorf("satnogs: malformed API key: contains whitespace or control characters")
+		}
+	}
+	return key, nil
+}
@@ -0,0 +1,37 @@
+package gosatnogs
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSetAPIKeyDuringRequests(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Token key-") {
+			http.Error(w, `{"detail":"bad token"}`, http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, `{"count":0,"results":[]}`)
+	}, WithAPIKey("key-0"))
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := c.SetAPIKey(fmt.Sprintf("key-%d", i)); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,83 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// Artifact describes a data file, such as an HDF5 waterfall or demodulated
+// data, attached to a SatNOGS network observation.
+type Artifact struct {
+	ID int `json:"id"`
+	// NetworkObsID is the ID of the observation on the Network API.
+	NetworkObsID int `json:"network_obs_id"`
+	// ArtifactFile is the URL of the artifact file.
+	ArtifactFile string `json:"artifact_file"`
+}
+
+// ArtifactResponse is a page of artifacts.
+type ArtifactResponse = Page[Artifact]
+
+// GetArtifacts retrieves the first page of artifacts matching params, e.g.
+// Params{"network_obs_id": {"1234"}}. Use GetArtifactResponse to follow
+// pagination.
+func (c *Client) GetArtifacts(params Params) ([]Artifact, error) {
+	return c.GetArtifactsContext(context.Background(), params)
+}
+
+// GetArtifactsContext is like GetArtifacts but binds the request to ctx.
+func (c *Client) GetArtifactsContext(ctx context.Context, params Params) ([]Artifact, error) {
+	resp, err := c.GetArtifactResponseContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *Client) GetArtifactResponse(params Params) (*ArtifactResponse, error) {
+	return c.GetArtifactResponseContext(context.Background(), params)
+}
+
+// GetArtifactResponseContext is like GetArtifactResponse but binds the
+// request to ctx.
+func (c *Client) GetArtifactResponseContext(ctx context.Context, params Params) (*ArtifactResponse, error) {
+	q := Params{"format": {"json"}}
+	for k, v := range params {
+		q[k] = v
+	}
+	var artifactResponse ArtifactResponse
+	if err := c.getJSON(ctx, "/artifacts/", q, &artifactResponse); err != nil {
+		return nil, err
+	}
+	return &artifactResponse, nil
+}
+
+// DownloadArtifact streams the file of a to w through the authenticated
+// client and returns the number of bytes written. The file is never held in
+// memory as a whole, and the response cache is bypassed. Files hosted outside
+// the API hosts, such as on object storage, are fetched without the API key.
+//
+// The timeout set with WithTimeout or on a client passed to WithHTTPClient
+// does not apply, since it would cut off large files mid-transfer; bound the
+// download with the context, WithCallTimeout or WithRequestTimeout instead.
+func (c *Client) DownloadArtifact(a Artifact, w io.Writer) (int64, error) {
+	return c.DownloadArtifactContext(context.Background(), a, w)
+}
+
+// DownloadArtifactContext is like DownloadArtifact but binds the request to
+// ctx.
+func (c *Client) DownloadArtifactContext(ctx context.Context, a Artifact, w io.Writer) (int64, error) {
+	ctx = WithRequestOptions(BypassCache(ctx), withoutClientTimeout())
+	if err := c.checkPageURL(a.ArtifactFile); errors.Is(err, ErrForeignURL) {
+		ctx = WithRequestOptions(ctx, withoutCredentials())
+	} else if err != nil {
+		return 0, err
+	}
+	resp, err := c.getURL(ctx, a.ArtifactFile)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return io.Copy(w, resp.Body)
+}
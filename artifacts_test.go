@@ -0,0 +1,69 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDownloadArtifactIgnoresClientTimeout(t *testing.T) {
+	const chunk = "0123456789"
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		for range 4 {
+			w.Write([]byte(chunk))
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}, WithTimeout(60*time.Millisecond))
+
+	var buf bytes.Buffer
+	n, err := c.DownloadArtifact(Artifact{ArtifactFile: srv.URL + "/media/artifact.h5"}, &buf)
+	if err != nil {
+		t.Fatalf("DownloadArtifact: %v", err)
+	}
+	if want := strings.Repeat(chunk, 4); n != int64(len(want)) || buf.String() != want {
+		t.Errorf("downloaded %d bytes %q, want %q", n, buf.String(), want)
+	}
+	if c.client.Timeout != 60*time.Millisecond {
+		t.Errorf("client timeout changed to %v", c.client.Timeout)
+	}
+}
+
+func TestDownloadArtifactCredentials(t *testing.T) {
+	var foreignAuth []string
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		foreignAuth = append(foreignAuth, r.Header.Get("Authorization"))
+		w.Write([]byte("foreign"))
+	}))
+	defer foreign.Close()
+
+	var apiAuth []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		apiAuth = append(apiAuth, r.Header.Get("Authorization"))
+		w.Write([]byte("api"))
+	})
+
+	for _, tt := range []struct {
+		url, want string
+	}{
+		{srv.URL + "/media/artifact.h5", "api"},
+		{foreign.URL + "/bucket/artifact.h5", "foreign"},
+	} {
+		var buf bytes.Buffer
+		if _, err := c.DownloadArtifact(Artifact{ArtifactFile: tt.url}, &buf); err != nil {
+			t.Fatalf("DownloadArtifact(%s): %v", tt.url, err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("DownloadArtifact(%s) = %q, want %q", tt.url, buf.String(), tt.want)
+		}
+	}
+	if len(apiAuth) != 1 || apiAuth[0] != "Token test-key" {
+		t.Errorf("API host saw Authorization %q, want the API key", apiAuth)
+	}
+	if len(foreignAuth) != 1 || foreignAuth[0] != "" {
+		t.Errorf("foreign host saw Authorization %q, want none", foreignAuth)
+	}
+}
@@ -0,0 +1,108 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending a request while the client's
+// circuit breaker is open.
+var ErrCircuitOpen = errors.New("satnogs: circuit breaker open")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker stops requests after repeated failures. Once threshold
+// consecutive attempts fail it opens, failing calls fast; after cooldown it
+// lets a single probe through and closes again if the probe succeeds.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// WithCircuitBreaker makes the client stop calling the API after threshold
+// consecutive attempts fail with a transport error or a 5xx status. While
+// open, calls fail immediately with ErrCircuitOpen. After cooldown a single
+// probe request is let through: its success closes the breaker, its failure
+// opens it for another cooldown. The breaker is shared by all goroutines
+// using the Client; ResetCircuitBreaker closes it manually.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) error {
+		if threshold < 1 {
+			return fmt.Errorf("satnogs: invalid circuit breaker threshold %d", threshold)
+		}
+		c.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown, now: func() time.Time { return c.clock.Now() }}
+		return nil
+	}
+}
+
+// ResetCircuitBreaker closes the client's circuit breaker and clears its
+// failure count. It does nothing if no breaker is configured.
+func (c *Client) ResetCircuitBreaker() {
+	if c.breaker == nil {
+		return
+	}
+	c.breaker.mu.Lock()
+	defer c.breaker.mu.Unlock()
+	c.breaker.state, c.breaker.failures, c.breaker.probing = breakerClosed, 0, false
+}
+
+// allow reports whether an attempt may be sent now.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return nil
+	case breakerHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of an attempt allowed by allow.
+func (b *circuitBreaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var apiErr *APIError
+	switch {
+	case err == nil || (errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError):
+		b.state, b.failures, b.probing = breakerClosed, 0, false
+	case ctx.Err() != nil || errors.Is(err, ErrBudgetExceeded):
+		// The caller gave up, or the attempt was never sent; that says
+		// nothing about the API's health.
+		b.probing = false
+	case b.state == breakerHalfOpen:
+		b.state, b.openedAt, b.probing = breakerOpen, b.now(), false
+	default:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.state, b.openedAt = breakerOpen, b.now()
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package gosatnogs
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerCooldown(t *testing.T) {
+	var healthy atomic.Bool
+	var hits atomic.Int32
+	clk := newFakeClock()
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if !healthy.Load() {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, fullPage)
+	}, WithCircuitBreaker(2, time.Minute), withClock(clk))
+	get := func() error {
+		_, err := c.GetTelemetry("AAAA-0000")
+		return err
+	}
+
+	for range 2 {
+		if err := get(); !errors.Is(err, ErrServer) {
+			t.Fatalf("err = %v, want ErrServer", err)
+		}
+	}
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v after threshold, want ErrCircuitOpen", err)
+	}
+	if n := hits.Load(); n != 2 {
+		t.Errorf("server saw %d requests, want 2 with the breaker open", n)
+	}
+
+	// A failed probe after the cooldown opens the breaker again.
+	clk.Advance(time.Minute)
+	if err := get(); !errors.Is(err, ErrServer) {
+		t.Fatalf("probe: err = %v, want ErrServer", err)
+	}
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v after failed probe, want ErrCircuitOpen", err)
+	}
+
+	// A successful one closes it.
+	healthy.Store(true)
+	clk.Advance(59 * time.Second)
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v before the cooldown ended, want ErrCircuitOpen", err)
+	}
+	clk.Advance(time.Second)
+	if err := get(); err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	if err := get(); err != nil {
+		t.Fatalf("after recovery: %v", err)
+	}
+	if n := hits.Load(); n != 5 {
+		t.Errorf("server saw %d requests, want 5", n)
+	}
+}
@@ -0,0 +1,250 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CacheMetadata describes a cached response body.
+type CacheMetadata struct {
+	// ETag and LastModified are the validators the server sent with the
+	// response; they are used to revalidate the entry once it expires.
+	ETag         string
+	LastModified string
+	// Header holds the headers of the original response.
+	Header http.Header
+	// Expires is when the entry stops being served without revalidation.
+	Expires time.Time
+}
+
+// Cache stores response bodies for the client. Keys are opaque strings
+// derived from the request URL and the client's credentials. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	Get(key string) ([]byte, CacheMetadata, bool)
+	Set(key string, value []byte, meta CacheMetadata)
+}
+
+// NeverCache is a TTL that keeps responses for an endpoint out of the cache.
+const NeverCache time.Duration = -1
+
+// defaultCacheTTLs are the freshness lifetimes used when a cache is enabled
+// and WithCacheTTL has not overridden them. Endpoints that are not listed get
+// a TTL of zero: their responses are stored but revalidated on every request.
+var defaultCacheTTLs = map[string]time.Duration{
+	"/telemetry/":    NeverCache,
+	"/satellites/":   24 * time.Hour,
+	"/transmitters/": 24 * time.Hour,
+	"/modes/":        24 * time.Hour,
+	"/tle/":          time.Hour,
+}
+
+// WithCache makes the client store responses in cache. A stored response is
+// served without contacting the API until its endpoint's TTL runs out (see
+// WithCacheTTL), after which it is revalidated with If-None-Match or
+// If-Modified-Since when the server supplied an ETag or Last-Modified header.
+// A 304 Not Modified answer is then served from the cached body.
+//
+// Cache keys include a digest of the API key, so responses fetched with one
+// token are never served to a client using a different token (or none). Use
+// BypassCache to force a fresh response for a single call.
+func WithCache(cache Cache) Option {
+	return func(c *Client) error {
+		c.cache = cache
+		return nil
+	}
+}
+
+// WithCacheTTL sets how long responses for endpoint (e.g. "/satellites/") stay
+// fresh in the cache. A zero TTL stores responses but revalidates them on every
+// request; NeverCache keeps the endpoint out of the cache entirely. By default
+// telemetry is never cached, satellites, transmitters and modes are cached for
+// 24 hours and TLEs for one hour.
+func WithCacheTTL(endpoint string, ttl time.Duration) Option {
+	return func(c *Client) error {
+		if c.cacheTTLs == nil {
+			c.cacheTTLs = make(map[string]time.Duration)
+		}
+		c.cacheTTLs[endpoint] = ttl
+		return nil
+	}
+}
+
+// WithConditionalCache enables an in-memory cache of up to maxEntries
+// responses. It is shorthand for WithCache(NewMemoryCache(maxEntries)).
+func WithConditionalCache(maxEntries int) Option {
+	return func(c *Client) error {
+		if maxEntries < 1 {
+			return fmt.Errorf("satnogs: invalid cache size %d", maxEntries)
+		}
+		c.cache = NewMemoryCache(maxEntries)
+		return nil
+	}
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a context that makes requests bound to it skip the
+// client's cache: nothing is served from or stored in it.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// cacheTTL returns the freshness lifetime for responses from endpoint.
+func (c *Client) cacheTTL(endpoint string) time.Duration {
+	if ttl, ok := c.cacheTTLs[endpoint]; ok {
+		return ttl
+	}
+	if ttl, ok := defaultCacheTTLs[endpoint]; ok {
+		return ttl
+	}
+	return 0
+}
+
+// cacheKey identifies req in the cache. The credential digest keeps responses
+// for different API keys apart.
+func (c *Client) cacheKey(req *http.Request) string {
+	return c.cacheKeyFor(req.Header.Get("Authorization"), req.URL.String())
+}
+
+// cacheKeyFor derives a key from an Authorization header value and a URL
+// without revealing the credentials.
+func (c *Client) cacheKeyFor(authorization, rawURL string) string {
+	sum := sha256.Sum256([]byte(authorization))
+	return hex.EncodeToString(sum[:8]) + " " + rawURL
+}
+
+// send performs one attempt of req, serving it from or revalidating it
+// against the cache when one is enabled.
+func (c *Client) send(req *http.Request, attempt int) (*http.Response, error) {
+	if c.cache == nil || req.Method != http.MethodGet || cacheBypassed(req.Context()) {
+		return c.roundTrip(req, attempt)
+	}
+	ttl := c.cacheTTL(c.endpoint(req.URL))
+	if ttl < 0 {
+		return c.roundTrip(req, attempt)
+	}
+
+	key := c.cacheKey(req)
+	body, meta, cached := c.cache.Get(key)
+	if cached && c.clock.Now().Before(meta.Expires) {
+		return cachedResponse(req, body, meta), nil
+	}
+	if cached {
+		if meta.ETag != "" {
+			req.Header.Set("If-None-Match", meta.ETag)
+		}
+		if meta.LastModified != "" {
+			req.Header.Set("If-Modified-Since", meta.LastModified)
+		}
+	}
+
+	resp, err := c.roundTrip(req, attempt)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified && cached {
+		resp.Body.Close()
+		meta.Expires = c.clock.Now().Add(ttl)
+		c.cache.Set(key, body, meta)
+		return cachedResponse(req, body, meta), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	c.cache.Set(key, body, CacheMetadata{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Header:       resp.Header.Clone(),
+		Expires:      c.clock.Now().Add(ttl),
+	})
+	return resp, nil
+}
+
+// cachedResponse rebuilds a 200 response for req from a cached body.
+func cachedResponse(req *http.Request, body []byte, meta CacheMetadata) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        meta.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entry
+// once it holds its maximum number of entries.
+type MemoryCache struct {
+	mu    sync.Mutex
+	max   int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	body []byte
+	meta CacheMetadata
+}
+
+// NewMemoryCache returns a MemoryCache holding at most maxEntries responses.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		max:   maxEntries,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+func (mc *MemoryCache) Get(key string) ([]byte, CacheMetadata, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	el, ok := mc.items[key]
+	if !ok {
+		return nil, CacheMetadata{}, false
+	}
+	mc.ll.MoveToFront(el)
+	e := el.Value.(*memoryCacheEntry)
+	return e.body, e.meta, true
+}
+
+func (mc *MemoryCache) Set(key string, value []byte, meta CacheMetadata) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	e := &memoryCacheEntry{key: key, body: value, meta: meta}
+	if el, ok := mc.items[key]; ok {
+		el.Value = e
+		mc.ll.MoveToFront(el)
+		return
+	}
+	mc.items[key] = mc.ll.PushFront(e)
+	for mc.ll.Len() > mc.max {
+		oldest := mc.ll.Back()
+		mc.ll.Remove(oldest)
+		delete(mc.items, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
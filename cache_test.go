@@ -0,0 +1,142 @@
+package gosatnogs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheSeparatesAPIKeys(t *testing.T) {
+	var hits atomic.Int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		writeJSON(w, `{"count":1,"results":[{"sat_id":"AAAA-0000","name":"`+r.Header.Get("Authorization")+`"}]}`)
+	}
+	cache := NewMemoryCache(16)
+	c, srv := newTestClient(t, handler, WithCache(cache), WithAPIKey("alpha"))
+
+	fetch := func(t *testing.T, c *Client, wantName string, wantHits int32) {
+		t.Helper()
+		sats, err := c.GetSatellites(SatelliteFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sats) != 1 || sats[0].Name != wantName {
+			t.Errorf("GetSatellites = %+v, want one satellite fetched as %q", sats, wantName)
+		}
+		if n := hits.Load(); n != wantHits {
+			t.Errorf("server saw %d requests, want %d", n, wantHits)
+		}
+	}
+
+	fetch(t, c, "Token alpha", 1)
+	fetch(t, c, "Token alpha", 1)
+
+	t.Run("SetAPIKey", func(t *testing.T) {
+		if err := c.SetAPIKey("beta"); err != nil {
+			t.Fatal(err)
+		}
+		fetch(t, c, "Token beta", 2)
+		if err := c.SetAPIKey(""); err != nil {
+			t.Fatal(err)
+		}
+		fetch(t, c, "", 3)
+	})
+
+	t.Run("shared cache", func(t *testing.T) {
+		other, err := New("gamma", WithBaseURL(srv.URL), WithCache(cache))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fetch(t, other, "Token gamma", 4)
+
+		same, err := New("alpha", WithBaseURL(srv.URL), WithCache(cache))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fetch(t, same, "Token alpha", 4)
+	})
+}
+
+func TestConditionalCacheRevalidation(t *testing.T) {
+	const lastModified = "Wed, 01 May 2024 12:00:00 GMT"
+	var (
+		mu       sync.Mutex
+		requests []string
+		served   int
+	)
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Header.Get("If-None-Match")+"|"+r.Header.Get("If-Modified-Since"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		served++
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", lastModified)
+		writeJSON(w, fmt.Sprintf(`{"count":1,"results":[{"sat_id":"AAAA-0000","name":"body %d"}]}`, served))
+	}, WithConditionalCache(8), WithCacheTTL("/satellites/", 0))
+
+	fetch := func(ctx context.Context, want string) {
+		t.Helper()
+		sats, err := c.GetSatellitesContext(ctx, SatelliteFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sats) != 1 || sats[0].Name != want {
+			t.Errorf("GetSatellites = %+v, want %q", sats, want)
+		}
+	}
+	ctx := context.Background()
+
+	fetch(ctx, "body 1")
+	// Revalidated and answered 304: the cached body is served.
+	fetch(ctx, "body 1")
+	// BypassCache sends no validators and stores nothing.
+	fetch(BypassCache(ctx), "body 2")
+	fetch(ctx, "body 1")
+
+	mu.Lock()
+	defer mu.Unlock()
+	validators := `"v1"|` + lastModified
+	want := []string{"|", validators, "|", validators}
+	if !slices.Equal(requests, want) {
+		t.Errorf("server saw validators %q, want %q", requests, want)
+	}
+}
+
+func TestConditionalCacheFreshEntries(t *testing.T) {
+	var hits atomic.Int32
+	clk := newFakeClock()
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		writeJSON(w, fullPage)
+	}, WithConditionalCache(8), withClock(clk))
+
+	for range 2 {
+		if _, err := c.GetSatellites(SatelliteFilter{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("server saw %d requests within the TTL, want 1", n)
+	}
+	clk.Advance(24 * time.Hour)
+	if _, err := c.GetSatellites(SatelliteFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := hits.Load(); n != 2 {
+		t.Errorf("server saw %d requests after the TTL, want 2", n)
+	}
+
+	if _, err := New("", WithConditionalCache(0)); err == nil {
+		t.Error("WithConditionalCache(0) accepted")
+	}
+}
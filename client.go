@@ -1,62 +1,359 @@
 package gosatnogs
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 const (
-	baseURL = "https://db.satnogs.org/api"
-)
+	baseURL = DBBaseURL
 
-type urlParam struct {
-	Key   string
-	Value string
-}
+	// DBBaseURL is the root of the SatNOGS DB API, the default base URL.
+	DBBaseURL = "https://db.satnogs.org/api"
+	// NetworkBaseURL is the root of the SatNOGS Network API, which serves
+	// observations and ground stations. Pass it to WithBaseURL to create a
+	// client for that API.
+	NetworkBaseURL = "https://network.satnogs.org/api"
 
+	// Version is the version of this library, reported in the default
+	// User-Agent header.
+	Version = "0.1.0"
+
+	defaultUserAgent = "go-satnogs/" + Version
+)
+
+// Client is a SatNOGS DB API client. A Client is safe for concurrent use by
+// multiple goroutines and should be shared rather than created per request:
+// its configuration is fixed once New returns, and the state that changes
+// while it is in use (the API key, cache, circuit breaker, failover state,
+// metrics and usage counters) is guarded internally. Hooks, middleware, caches and metrics
+// recorders supplied through options are called from many goroutines at
+// once and must be safe for concurrent use themselves.
 type Client struct {
 	client  *http.Client
 	baseURL string
-	apiKey  string
+	// keyMu guards apiKey, which SetAPIKey may change while requests are in
+	// flight.
+	keyMu     sync.RWMutex
+	apiKey    string
+	userAgent string
+	retry     RetryConfig
+	// header holds the extra headers set with WithHeader.
+	header http.Header
+	// requestTimeout is the default deadline applied to each call's context.
+	requestTimeout time.Duration
+	fallbacks      []string
+	failover       failoverState
+	limiter        *rate.Limiter
+	hedge          hedgeConfig
+	breaker        *circuitBreaker
+
+	requestHooks  []RequestHook
+	responseHooks []ResponseHook
+	middleware    []Middleware
+	debug         *debugLogger
+	metrics       MetricsRecorder
+	cache         Cache
+	cacheTTLs     map[string]time.Duration
+	usage         usage
+	flights       *singleflight.Group
+
+	// debugBodyLimit is the body limit of the logger created by WithDebug,
+	// kept apart so WithDebugBodyLimit may come first.
+	debugBodyLimit int
+	// maxPages bounds how many pages the GetAll helpers fetch. Zero means
+	// no limit.
+	maxPages int
+	// pageSize is the page_size sent with telemetry requests. Zero leaves
+	// it to the server.
+	pageSize int
+	// tolerant makes pages skip results that fail to decode.
+	tolerant bool
+	// rawCapture keeps the raw JSON of pages and their results.
+	rawCapture bool
+	// strict makes JSON decoding reject unknown fields.
+	strict bool
+	tracer Tracer
+	// rawPageURLs disables rebasing of Next and Prev links.
+	rawPageURLs bool
+	// concurrency bounds helpers that fan out over several satellites.
+	concurrency int
+
+	// clock tells the time and waits between retries. Tests replace it to
+	// avoid real delays.
+	clock clock
+
+	// closed is set by Close.
+	closed atomic.Bool
+
+	// err holds an option error deferred by NewClient. It is returned by
+	// every request made with the client.
+	err error
 }
 
-func NewClient(apiKey string) *Client {
-	return &Client{
+// New returns a Client for the SatNOGS DB API authenticated with apiKey, or an
+// error if any of the options is invalid. An empty apiKey sends
+// unauthenticated requests. Surrounding whitespace and a pasted "Token "
+// prefix are stripped from the key; a key with embedded whitespace is
+// rejected. Without options the client talks to
+// https://db.satnogs.org/api using its own http.Client with a 10 second timeout.
+func New(apiKey string, opts ...Option) (*Client, error) {
+	c := &Client{
 		client: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout: defaultTimeout,
 		},
-		baseURL: baseURL,
-		apiKey:  apiKey,
+		baseURL:        baseURL,
+		apiKey:         apiKey,
+		userAgent:      defaultUserAgent,
+		debugBodyLimit: defaultDebugBodyLimit,
+		clock:          realClock{},
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	key, err := normalizeAPIKey(c.apiKey)
+	if err != nil {
+		return nil, err
+	}
+	c.apiKey = key
+	return c, nil
+}
+
+// NewClient is like New but never fails. If an option is invalid, the returned
+// client reports that error from every request instead.
+func NewClient(apiKey string, opts ...Option) *Client {
+	c, err := New(apiKey, opts...)
+	if err != nil {
+		return &Client{err: err}
 	}
+	return c
 }
 
-func (c *Client) Get(endpoint string, params []urlParam) (*http.Response, error) {
+// Get is equivalent to GetWithContext using context.Background(). The caller
+// must close the body of the returned response.
+func (c *Client) Get(endpoint string, params Params) (*http.Response, error) {
+	return c.GetWithContext(context.Background(), endpoint, params)
+}
+
+// GetWithContext issues a GET request against endpoint (relative to the client's
+// base URL) with the given query parameters. The request is bound to ctx, so
+// cancelling ctx aborts the request.
+//
+// If the API responds with a non-2xx status the body is closed and an
+// *APIError is returned instead of the response. Otherwise the caller owns
+// the response body and must close it.
+func (c *Client) GetWithContext(ctx context.Context, endpoint string, params Params) (*http.Response, error) {
+	rawURL, err := c.endpointURL(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.getURL(ctx, rawURL)
+}
+
+// endpointURL returns the absolute URL of endpoint with params as its query.
+func (c *Client) endpointURL(endpoint string, params Params) (string, error) {
 	// Create URL
 	u, err := url.Parse(c.baseURL + endpoint)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	// Add query parameters
 	q := u.Query()
-	for _, param := range params {
-		q.Add(param.Key, param.Value) // This automatically URL-encodes the values
+	for key, values := range params {
+		for _, value := range values {
+			q.Add(key, value) // This automatically URL-encodes the values
+		}
 	}
 	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// getURL issues an authenticated GET request for an absolute URL.
+func (c *Client) getURL(ctx context.Context, rawURL string) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.closed.Load() {
+		return nil, ErrClientClosed
+	}
+
+	// Apply a per-call timeout, kept alive until the body is closed
+	ro := requestOptionsFrom(ctx)
+	var cancel context.CancelFunc
+	if ro.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, ro.timeout)
+	} else if c.requestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+	} else {
+		cancel = func() {}
+	}
 
 	// Create request
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
+	c.setHeaders(req, ro)
+	req.Header.Set("Accept-Encoding", "gzip")
+
 	// Add authorization header if API key is set
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", "Token "+c.apiKey)
+	if key := c.key(); key != "" && !ro.noCredentials {
+		req.Header.Set("Authorization", "Token "+key)
+	}
+	resp, err := c.doFailover(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// setHeaders sets the User-Agent and the extra headers of the client and of
+// the call described by ro on req. Authorization is never taken from them.
+func (c *Client) setHeaders(req *http.Request, ro requestOptions) {
+	req.Header.Set("User-Agent", c.userAgent)
+	for _, h := range []http.Header{c.header, ro.header} {
+		for key, values := range h {
+			if key != "Authorization" {
+				req.Header[key] = append([]string(nil), values...)
+			}
+		}
+	}
+}
+
+// GetJSON issues a GET request for endpoint with the given query parameters
+// and decodes the JSON response into out, closing the body afterwards. It is
+// the convenient way to call endpoints the library does not wrap yet; use
+// GetWithContext when the raw response is needed.
+func (c *Client) GetJSON(ctx context.Context, endpoint string, params Params, out any) error {
+	return c.getJSON(ctx, endpoint, params, out)
+}
+
+// getJSON issues a GET request for endpoint and decodes the JSON response
+// body into out.
+func (c *Client) getJSON(ctx context.Context, endpoint string, params Params, out any) error {
+	rawURL, err := c.endpointURL(endpoint, params)
+	if err != nil {
+		return err
+	}
+	return c.getURLJSON(ctx, rawURL, out)
+}
+
+// getURLJSON is like getJSON for an absolute URL, such as a Next or Prev link.
+// A successful response whose body turns out to be truncated is requested
+// again according to the retry configuration.
+func (c *Client) getURLJSON(ctx context.Context, rawURL string, out any) error {
+	for retries := 0; ; retries++ {
+		received, err := c.fetchJSON(ctx, rawURL, out)
+		if err == nil {
+			if p, ok := out.(pageLinks); ok {
+				p.rebaseLinks(c, rawURL)
+			}
+			return nil
+		}
+		if !received || !truncatedBody(err) || retries >= c.retry.MaxRetries || ctx.Err() != nil {
+			return err
+		}
+		// Discard whatever the partial body decoded into out
+		reflect.ValueOf(out).Elem().SetZero()
+		if sleepErr := c.clock.Sleep(ctx, c.retry.backoff(retries)); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// fetchJSON requests rawURL once and decodes the body into out. received
+// reports whether a successful response arrived, so that a returned error
+// stems from its body.
+func (c *Client) fetchJSON(ctx context.Context, rawURL string, out any) (received bool, err error) {
+	if c.flights != nil && shareable(ctx) {
+		return c.getSharedJSON(ctx, rawURL, out)
+	}
+	resp, err := c.getURL(ctx, rawURL)
+	if err != nil {
+		return false, err
+	}
+	return true, c.decodeBody(resp, rawURL, out)
+}
+
+// maxDrain bounds how much of an unread body is discarded to let the
+// connection be reused; larger leftovers are cheaper to drop with the
+// connection.
+const maxDrain = 256 << 10
+
+// decodeBody decodes the JSON body of resp, a response for rawURL, into out,
+// then drains and closes the body so the underlying connection can be reused
+// even when decoding stopped early.
+func (c *Client) decodeBody(resp *http.Response, rawURL string, out any) error {
+	defer func() {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrain))
+		resp.Body.Close()
+	}()
+	return c.decodeJSON(resp.Body, rawURL, out)
+}
+
+// newDecoder returns a JSON decoder for r that honours WithStrictDecoding.
+func (c *Client) newDecoder(r io.Reader) *json.Decoder {
+	dec := json.NewDecoder(r)
+	if c.strict {
+		dec.DisallowUnknownFields()
+	}
+	return dec
+}
+
+// decodeJSON decodes a response body for rawURL into out.
+func (c *Client) decodeJSON(r io.Reader, rawURL string, out any) error {
+	td, tolerant := out.(tolerantDecoder)
+	tolerant = tolerant && c.tolerant
+	if !c.rawCapture && !tolerant {
+		return c.decodeError(rawURL, c.newDecoder(r).Decode(out))
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if tolerant {
+		return td.decodeTolerant(c, data, rawURL)
+	}
+	if err := c.newDecoder(bytes.NewReader(data)).Decode(out); err != nil {
+		return c.decodeError(rawURL, err)
 	}
-	return c.client.Do(req)
+	if rc, ok := out.(rawCapturer); ok {
+		return rc.captureRaw(data)
+	}
+	return nil
+}
+
+// decodeError names the endpoint of rawURL in an error about an unknown
+// field, which only strict decoding reports.
+func (c *Client) decodeError(rawURL string, err error) error {
+	if err == nil || !c.strict || !strings.HasPrefix(err.Error(), "json: unknown field ") {
+		return err
+	}
+	endpoint := rawURL
+	if u, perr := url.Parse(rawURL); perr == nil {
+		endpoint = c.endpoint(u)
+	}
+	return fmt.Errorf("satnogs: %s response does not match the schema: %w", endpoint, err)
 }
 
 type Telemetry struct {
@@ -67,18 +364,24 @@ type Telemetry struct {
 	Decoded       string    `json:"decoded"`
 	Frame         string    `json:"frame"`
 	Observer      string    `json:"observer"`
-	Timestamp     time.Time `json:"timestamp"`
+	Timestamp     Timestamp `json:"timestamp"`
 	Version       string    `json:"version"`
 	ObservationID int       `json:"observation_id"`
 	StationID     int       `json:"station_id"`
+
+	// Raw is the JSON object the record was decoded from, including fields
+	// the struct does not capture. It is only set by clients created with
+	// WithRawCapture.
+	Raw json.RawMessage `json:"-"`
 }
 
-type TelemetryResponse struct {
-	Next    string      `json:"next"`
-	Prev    string      `json:"prev"`
-	Results []Telemetry `json:"results"`
+func (t *Telemetry) setRaw(raw json.RawMessage) {
+	t.Raw = raw
 }
 
+// TelemetryResponse is a page of telemetry records.
+type TelemetryResponse = Page[Telemetry]
+
 // GetTelemetry retrieves telemetry data for a specific satellite from the SatNOGS database.
 // It returns a slice of Telemetry structs containing the decoded data, or an error if the request fails.
 //
@@ -89,80 +392,218 @@ type TelemetryResponse struct {
 //   - []Telemetry: A slice of Telemetry structs containing the satellite's telemetry data
 //   - error: An error object if the request fails or if the response cannot be decoded
 //
+// Non-2xx responses are returned as an *APIError; a 404 for an unknown
+// satellite matches ErrNotFound via errors.Is. A satellite without telemetry
+// yields an empty slice and a nil error.
+//
 // Note: This function only returns the first page of results. For complete telemetry data,
 // consider using GetTelemetryResponse() which has pagination support.
 func (c *Client) GetTelemetry(satelliteID string) ([]Telemetry, error) {
-	resp, err := c.GetTelemetryResponse(satelliteID)
+	return c.GetTelemetryContext(context.Background(), satelliteID)
+}
+
+// GetTelemetryContext is like GetTelemetry but binds the request to ctx.
+func (c *Client) GetTelemetryContext(ctx context.Context, satelliteID string) ([]Telemetry, error) {
+	resp, err := c.GetTelemetryResponseContext(ctx, satelliteID)
 	if err != nil {
 		return nil, err
 	}
 	return resp.Results, nil
 }
 
-func (c *Client) GetTelemetryResponse(satelliteID string) (*TelemetryResponse, error) {
-	resp, err := c.Get("/telemetry/", []urlParam{{"sat_id", satelliteID}, {"format", "json"}})
+// GetLatestTelemetry returns the most recent telemetry record for a satellite.
+// Only the first page is requested, since the API returns records newest
+// first. If the satellite has no telemetry the error is ErrNoTelemetry.
+func (c *Client) GetLatestTelemetry(satelliteID string) (*Telemetry, error) {
+	return c.GetLatestTelemetryContext(context.Background(), satelliteID)
+}
+
+// GetLatestTelemetryContext is like GetLatestTelemetry but binds the request to ctx.
+func (c *Client) GetLatestTelemetryContext(ctx context.Context, satelliteID string) (*Telemetry, error) {
+	resp, err := c.GetTelemetryResponseContext(ctx, satelliteID)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	if len(resp.Results) == 0 {
+		return nil, ErrNoTelemetry
+	}
+	return &resp.Results[0], nil
+}
 
-	var telemetryResponse TelemetryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&telemetryResponse); err != nil {
-		return nil, err
+func (c *Client) GetTelemetryResponse(satelliteID string) (*TelemetryResponse, error) {
+	return c.GetTelemetryResponseContext(context.Background(), satelliteID)
+}
+
+// GetTelemetryResponseContext is like GetTelemetryResponse but binds the request to ctx.
+func (c *Client) GetTelemetryResponseContext(ctx context.Context, satelliteID string) (*TelemetryResponse, error) {
+	return c.getTelemetryResponse(ctx, Params{"sat_id": {satelliteID}, "format": {"json"}})
+}
+
+// GetTelemetryResponseWithParams is like GetTelemetryResponse but adds extra
+// to the query, for filters the library has no dedicated method for yet. An
+// extra key that is also set by default, such as "format" or "sat_id",
+// replaces the default value.
+func (c *Client) GetTelemetryResponseWithParams(satelliteID string, extra map[string]string) (*TelemetryResponse, error) {
+	return c.GetTelemetryResponseWithParamsContext(context.Background(), satelliteID, extra)
+}
+
+// GetTelemetryResponseWithParamsContext is like GetTelemetryResponseWithParams
+// but binds the request to ctx.
+func (c *Client) GetTelemetryResponseWithParamsContext(ctx context.Context, satelliteID string, extra map[string]string) (*TelemetryResponse, error) {
+	params := Params{"sat_id": {satelliteID}, "format": {"json"}}
+	for key, value := range extra {
+		params.Set(key, value)
 	}
-	return &telemetryResponse, nil
+	return c.getTelemetryResponse(ctx, params)
 }
 
-func (c *Client) GetTelemetryResponseNextPage(t *TelemetryResponse) (*TelemetryResponse, error) {
-	if t.Next == "" {
-		return nil, nil
+// GetTelemetryInRange retrieves the first page of telemetry for a satellite
+// with timestamps between start and end. Either bound may be the zero time to
+// leave that side of the range open. Use the pagination helpers on the
+// returned response to fetch further pages.
+func (c *Client) GetTelemetryInRange(satelliteID string, start, end time.Time) (*TelemetryResponse, error) {
+	return c.GetTelemetryInRangeContext(context.Background(), satelliteID, start, end)
+}
+
+// GetTelemetryInRangeContext is like GetTelemetryInRange but binds the request to ctx.
+func (c *Client) GetTelemetryInRangeContext(ctx context.Context, satelliteID string, start, end time.Time) (*TelemetryResponse, error) {
+	params := Params{"sat_id": {satelliteID}, "format": {"json"}}
+	if !start.IsZero() {
+		params.SetTime("start", start)
 	}
-	// Create request
-	req, err := http.NewRequest("GET", t.Next, nil)
-	if err != nil {
-		return nil, err
+	if !end.IsZero() {
+		params.SetTime("end", end)
 	}
+	return c.getTelemetryResponse(ctx, params)
+}
 
-	// Add authorization header if API key is set
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", "Token "+c.apiKey)
+// GetTelemetryByNoradID retrieves the first page of telemetry for the
+// satellite with the given NORAD catalog ID, for callers that key satellites
+// by it rather than by SatNOGS satellite ID. Use the pagination helpers on the
+// returned response to fetch further pages.
+func (c *Client) GetTelemetryByNoradID(noradID int) (*TelemetryResponse, error) {
+	return c.GetTelemetryByNoradIDContext(context.Background(), noradID)
+}
+
+// GetTelemetryByNoradIDContext is like GetTelemetryByNoradID but binds the
+// request to ctx.
+func (c *Client) GetTelemetryByNoradIDContext(ctx context.Context, noradID int) (*TelemetryResponse, error) {
+	if noradID <= 0 {
+		return nil, fmt.Errorf("satnogs: invalid NORAD ID %d", noradID)
 	}
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
+	params := Params{"format": {"json"}}
+	params.SetInt("norad_cat_id", noradID)
+	return c.getTelemetryResponse(ctx, params)
+}
+
+// telemetryParams adds the client's page size to params, the query of a
+// telemetry request, unless the caller chose one. Every request for the first
+// page of /telemetry/ goes through it; later pages inherit the size from the
+// Next links the server returns.
+func (c *Client) telemetryParams(params Params) Params {
+	if c.pageSize > 0 && params.Get("page_size") == "" {
+		params.SetInt("page_size", c.pageSize)
 	}
-	defer resp.Body.Close()
+	return params
+}
 
+func (c *Client) getTelemetryResponse(ctx context.Context, params Params) (*TelemetryResponse, error) {
+	params = c.telemetryParams(params)
+	ctx, span := c.startSpan(ctx, "GetTelemetry")
+	span.SetAttribute(AttrEndpoint, "/telemetry/")
+	if id := params.Get("sat_id"); id != "" {
+		span.SetAttribute(AttrSatelliteID, id)
+	}
 	var telemetryResponse TelemetryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&telemetryResponse); err != nil {
+	if err := c.getJSON(ctx, "/telemetry/", params, &telemetryResponse); err != nil {
+		span.End(err)
 		return nil, err
 	}
+	span.SetAttribute(AttrResultCount, len(telemetryResponse.Results))
+	span.End(nil)
 	return &telemetryResponse, nil
 }
 
+// GetTelemetryResponseNextPage fetches the page after t. It returns nil and a
+// nil error when t is the last page; use FetchNext for an ErrNoMorePages
+// sentinel instead.
+func (c *Client) GetTelemetryResponseNextPage(t *TelemetryResponse) (*TelemetryResponse, error) {
+	return c.GetTelemetryResponseNextPageContext(context.Background(), t)
+}
+
+// GetTelemetryResponseNextPageContext is like GetTelemetryResponseNextPage but binds
+// the request to ctx.
+func (c *Client) GetTelemetryResponseNextPageContext(ctx context.Context, t *TelemetryResponse) (*TelemetryResponse, error) {
+	return NextPage(ctx, c, t)
+}
+
+// GetTelemetryResponsePrevPage fetches the page before t. It returns nil and a
+// nil error when t is the first page; use FetchPrev for an ErrNoMorePages
+// sentinel instead.
 func (c *Client) GetTelemetryResponsePrevPage(t *TelemetryResponse) (*TelemetryResponse, error) {
-	if t.Prev == "" {
-		return nil, nil
-	}
-	// Create request
-	req, err := http.NewRequest("GET", t.Prev, nil)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetTelemetryResponsePrevPageContext(context.Background(), t)
+}
 
-	// Add authorization header if API key is set
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", "Token "+c.apiKey)
-	}
-	resp, err := c.client.Do(req)
+// GetTelemetryResponsePrevPageContext is like GetTelemetryResponsePrevPage but binds
+// the request to ctx.
+func (c *Client) GetTelemetryResponsePrevPageContext(ctx context.Context, t *TelemetryResponse) (*TelemetryResponse, error) {
+	return PrevPage(ctx, c, t)
+}
+
+// GetTelemetryResponseAt fetches the telemetry page at cursorURL, typically a
+// Next or Prev link saved from an earlier response, so an interrupted
+// download can be resumed. The URL must point at the client's API host;
+// otherwise an error matching ErrForeignURL is returned without sending a
+// request.
+func (c *Client) GetTelemetryResponseAt(cursorURL string) (*TelemetryResponse, error) {
+	return c.GetTelemetryResponseAtContext(context.Background(), cursorURL)
+}
+
+// GetTelemetryResponseAtContext is like GetTelemetryResponseAt but binds the
+// request to ctx.
+func (c *Client) GetTelemetryResponseAtContext(ctx context.Context, cursorURL string) (*TelemetryResponse, error) {
+	return fetchPage[Telemetry](ctx, c, cursorURL)
+}
+
+// GetTelemetryPage fetches the telemetry page at pageURL, such as a Next link
+// persisted by a sync job that is now resuming. It is GetTelemetryResponseAtContext
+// under the name used alongside FetchNext and FetchPrev, and likewise rejects
+// a URL outside the client's API host with an error matching ErrForeignURL.
+func (c *Client) GetTelemetryPage(ctx context.Context, pageURL string) (*TelemetryResponse, error) {
+	return c.GetTelemetryResponseAtContext(ctx, pageURL)
+}
+
+// GetAllTelemetry retrieves every page of telemetry for a satellite by
+// following Next links until the last page, and returns the concatenated
+// results. The context is checked between pages.
+//
+// If the client was configured with WithMaxPages and the history has more
+// pages than allowed, the records fetched so far are returned together with
+// an error matching ErrMaxPages.
+func (c *Client) GetAllTelemetry(ctx context.Context, satelliteID string) (all []Telemetry, err error) {
+	ctx, span := c.startSpan(ctx, "GetAllTelemetry")
+	span.SetAttribute(AttrSatelliteID, satelliteID)
+	defer func() {
+		span.SetAttribute(AttrResultCount, len(all))
+		span.End(err)
+	}()
+
+	page, err := c.GetTelemetryResponseContext(ctx, satelliteID)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var telemetryResponse TelemetryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&telemetryResponse); err != nil {
-		return nil, err
+	all = page.Results
+	for pages := 1; page.Next != ""; pages++ {
+		if c.maxPages > 0 && pages >= c.maxPages {
+			return all, fmt.Errorf("%w: stopped after %d pages", ErrMaxPages, pages)
+		}
+		if err := ctx.Err(); err != nil {
+			return all, err
+		}
+		if page, err = c.GetTelemetryResponseNextPageContext(ctx, page); err != nil {
+			return all, err
+		}
+		all = append(all, page.Results...)
 	}
-	return &telemetryResponse, nil
+	return all, nil
 }
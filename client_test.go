@@ -0,0 +1,167 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetTelemetryConcurrent(t *testing.T) {
+	metrics := &MemoryMetrics{}
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"count":1,"results":[{"sat_id":"`+r.URL.Query().Get("sat_id")+`"}]}`)
+	},
+		WithConditionalCache(8),
+		WithCacheTTL("/telemetry/", time.Minute),
+		WithMetrics(metrics),
+		WithRetries(2),
+		WithCircuitBreaker(100, time.Second),
+	)
+
+	const goroutines = 50
+	var wg sync.WaitGroup
+	for i := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			satID := fmt.Sprintf("SAT-%d", i%20)
+			for range 5 {
+				got, err := c.GetTelemetry(satID)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if len(got) != 1 || got[0].SatID != satID {
+					t.Errorf("GetTelemetry(%q) = %+v", satID, got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if s := c.Stats(); s.Requests == 0 {
+		t.Errorf("Stats().Requests = 0 after %d goroutines", goroutines)
+	}
+}
+
+func TestResumeFromCursor(t *testing.T) {
+	srv := httptest.NewServer(pagedTelemetry(7))
+	defer srv.Close()
+	ctx := context.Background()
+
+	first, err := New("", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := first.GetTelemetryResponse("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := page.Next
+
+	// A new client, as after a restart, picks up at the saved cursor.
+	resumed, err := New("", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err = resumed.GetTelemetryResponseAt(cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int
+	for {
+		for _, rec := range page.Results {
+			ids = append(ids, rec.ObservationID)
+		}
+		if page, err = FetchNext(ctx, resumed, page); errors.Is(err, ErrNoMorePages) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := []int{3, 4, 5, 6, 7}; !slices.Equal(ids, want) {
+		t.Errorf("resumed records = %v, want %v", ids, want)
+	}
+
+	if _, err := resumed.GetTelemetryPage(ctx, cursor); err != nil {
+		t.Errorf("GetTelemetryPage(%q): %v", cursor, err)
+	}
+	if _, err := resumed.GetTelemetryResponseAt("::not a url"); err == nil {
+		t.Error("GetTelemetryResponseAt accepted a malformed cursor")
+	}
+}
+
+func TestStrictDecodingExtraField(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/telemetry_extra_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) { writeJSON(w, string(fixture)) }
+	ctx := context.Background()
+	stream := func(c *Client) error {
+		return c.StreamTelemetry(ctx, "XUSN-0095-5010-0047-8935", func(Telemetry) error { return nil })
+	}
+
+	lenient, _ := newTestClient(t, handler)
+	got, err := lenient.GetTelemetry("XUSN-0095-5010-0047-8935")
+	if err != nil {
+		t.Fatalf("lenient GetTelemetry: %v", err)
+	}
+	if len(got) != 1 || got[0].StationID != 1361 || got[0].Observer != "N0CALL-EM12" {
+		t.Errorf("lenient GetTelemetry = %+v", got)
+	}
+	if err := stream(lenient); err != nil {
+		t.Errorf("lenient StreamTelemetry: %v", err)
+	}
+
+	strict, _ := newTestClient(t, handler, WithStrictDecoding())
+	_, err = strict.GetTelemetry("XUSN-0095-5010-0047-8935")
+	for name, err := range map[string]error{"GetTelemetry": err, "StreamTelemetry": stream(strict)} {
+		if err == nil {
+			t.Errorf("strict %s accepted the extra field", name)
+			continue
+		}
+		if msg := err.Error(); !strings.Contains(msg, `"snr"`) || !strings.Contains(msg, "/telemetry/") {
+			t.Errorf("strict %s: error %q does not name the field and endpoint", name, msg)
+		}
+	}
+}
+
+func TestGetAllTelemetryMaxPages(t *testing.T) {
+	for _, tt := range []struct {
+		maxPages, wantRecords, wantRequests int
+		wantErr                             error
+	}{
+		{maxPages: 3, wantRecords: 6, wantRequests: 3, wantErr: ErrMaxPages},
+		{maxPages: 5, wantRecords: 10, wantRequests: 5},
+		{maxPages: 0, wantRecords: 10, wantRequests: 5},
+	} {
+		var requests atomic.Int32
+		paged := pagedTelemetry(10)
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			paged(w, r)
+		}, WithMaxPages(tt.maxPages))
+
+		all, err := c.GetAllTelemetry(context.Background(), "AAAA-0000")
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("WithMaxPages(%d): err = %v, want %v", tt.maxPages, err, tt.wantErr)
+		}
+		if len(all) != tt.wantRecords || int(requests.Load()) != tt.wantRequests {
+			t.Errorf("WithMaxPages(%d): %d records in %d requests, want %d in %d",
+				tt.maxPages, len(all), requests.Load(), tt.wantRecords, tt.wantRequests)
+		}
+	}
+	if _, err := New("", WithMaxPages(-1)); err == nil {
+		t.Error("WithMaxPages(-1) accepted")
+	}
+}
@@ -0,0 +1,34 @@
+package gosatnogs
+
+import (
+	"context"
+	"time"
+)
+
+// clock is the client's source of time. Tests substitute a fake one so that
+// retries, backoff, rate limiting and expiry can be exercised without real
+// delays.
+type clock interface {
+	Now() time.Time
+	// Sleep waits for d or until ctx is done, returning ctx.Err() in the
+	// latter case.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// realClock is the default clock, backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	return sleepContext(ctx, d)
+}
+
+// withClock makes the client use clk. It is unexported so the clock is not
+// part of the public API; tests in this package use it.
+func withClock(clk clock) Option {
+	return func(c *Client) error {
+		c.clock = clk
+		return nil
+	}
+}
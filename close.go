@@ -0,0 +1,22 @@
+package gosatnogs
+
+import "errors"
+
+// ErrClientClosed is returned by every request made with a client after
+// Close.
+var ErrClientClosed = errors.New("satnogs: client closed")
+
+// Close releases the client's resources: it closes the idle keep-alive
+// connections of its transport and makes every later request fail with
+// ErrClientClosed. Requests already in flight are not interrupted; cancel
+// their contexts for that. If the transport is shared with other clients,
+// through WithHTTPClient or WithTransport, their idle connections are closed
+// too, which is harmless but costs them a reconnect. Close may be called more
+// than once and always returns nil.
+func (c *Client) Close() error {
+	if c.closed.Swap(true) || c.client == nil {
+		return nil
+	}
+	c.client.CloseIdleConnections()
+	return nil
+}
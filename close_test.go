@@ -0,0 +1,38 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCloseRejectsLaterRequests(t *testing.T) {
+	var hits atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		writeJSON(w, `{"count":0,"results":[]}`)
+	})
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+
+	ctx := context.Background()
+	calls := map[string]func() error{
+		"GetTelemetry": func() error { _, err := c.GetTelemetry("AAAA-0000"); return err },
+		"Ping":         func() error { return c.Ping(ctx) },
+		"HealthCheck":  func() error { return c.HealthCheck(ctx) },
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, ErrClientClosed) {
+			t.Errorf("%s after Close: err = %v, want ErrClientClosed", name, err)
+		}
+	}
+	if n := hits.Load(); n != 0 {
+		t.Errorf("server saw %d requests after Close, want 0", n)
+	}
+}
@@ -0,0 +1,53 @@
+package gosatnogs
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+var telemetryCSVHeader = []string{
+	"sat_id",
+	"norad_cat_id",
+	"transmitter",
+	"app_source",
+	"decoded",
+	"frame",
+	"observer",
+	"timestamp",
+	"version",
+	"observation_id",
+	"station_id",
+}
+
+// WriteTelemetryCSV writes records to w as CSV: a header row named after the
+// API's JSON fields, then one row per record with Timestamp formatted as
+// RFC 3339. Any write error, including one surfaced while flushing, is
+// returned.
+func WriteTelemetryCSV(w io.Writer, records []Telemetry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(telemetryCSVHeader); err != nil {
+		return err
+	}
+	for _, t := range records {
+		row := []string{
+			t.SatID,
+			strconv.Itoa(t.NoradCatID),
+			t.Transmitter,
+			t.AppSource,
+			t.Decoded,
+			t.Frame,
+			t.Observer,
+			t.Timestamp.Format(time.RFC3339),
+			t.Version,
+			strconv.Itoa(t.ObservationID),
+			strconv.Itoa(t.StationID),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,60 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWriteTelemetryCSVGolden(t *testing.T) {
+	records := []Telemetry{
+		{
+			SatID:         "XUSN-0095-5010-0047-8935",
+			NoradCatID:    25544,
+			Transmitter:   "zt8cCgKBQVixMHzCPKP4yD",
+			AppSource:     "network",
+			Decoded:       "plain",
+			Frame:         "8A8E9C8E4040E0AE",
+			Observer:      "N0CALL-EM12",
+			Timestamp:     Timestamp{time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+			Version:       "1.2",
+			ObservationID: 9411234,
+			StationID:     1361,
+		},
+		{
+			SatID:         "XUSN-0095-5010-0047-8935",
+			NoradCatID:    25544,
+			AppSource:     "sids",
+			Decoded:       "temp=21.5, mode=\"safe\"\nbattery ok",
+			Frame:         "C0FFEE",
+			Observer:      "K1ABC, portable",
+			Timestamp:     Timestamp{time.Date(2024, 5, 1, 14, 0, 10, 0, time.FixedZone("CEST", 2*60*60))},
+			ObservationID: 9411235,
+			StationID:     7,
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteTelemetryCSV(&buf, records); err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("testdata/telemetry.golden.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("WriteTelemetryCSV wrote\n%s\nwant\n%s", buf.Bytes(), want)
+	}
+}
+
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestWriteTelemetryCSVWriteError(t *testing.T) {
+	boom := errors.New("disk full")
+	if err := WriteTelemetryCSV(failingWriter{boom}, []Telemetry{{SatID: "AAAA-0000"}}); !errors.Is(err, boom) {
+		t.Errorf("err = %v, want the write error", err)
+	}
+}
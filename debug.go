@@ -0,0 +1,94 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// defaultDebugBodyLimit is how many bytes of each response body WithDebug
+// writes unless changed with WithDebugBodyLimit.
+const defaultDebugBodyLimit = 4 << 10
+
+// debugLogger writes the wire form of requests and responses to w.
+type debugLogger struct {
+	mu        sync.Mutex
+	w         io.Writer
+	bodyLimit int
+}
+
+// WithDebug writes every request and response the client exchanges to w in
+// wire format, with the Authorization token redacted. Response bodies are
+// truncated to 4 KiB; see WithDebugBodyLimit. Writes are serialized, so w
+// need not be safe for concurrent use.
+func WithDebug(w io.Writer) Option {
+	return func(c *Client) error {
+		c.debug = &debugLogger{w: w, bodyLimit: c.debugBodyLimit}
+		return nil
+	}
+}
+
+// WithDebugBodyLimit sets how many bytes of each response body WithDebug
+// writes. A negative limit writes bodies in full. It has no effect unless
+// WithDebug is also given, in either order.
+func WithDebugBodyLimit(n int) Option {
+	return func(c *Client) error {
+		c.debugBodyLimit = n
+		if c.debug != nil {
+			c.debug.bodyLimit = n
+		}
+		return nil
+	}
+}
+
+func (d *debugLogger) dumpRequest(req *http.Request) {
+	redacted := req.Clone(req.Context())
+	if redacted.Header.Get("Authorization") != "" {
+		redacted.Header.Set("Authorization", "Token [REDACTED]")
+	}
+	dump, err := httputil.DumpRequestOut(redacted, true)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err != nil {
+		fmt.Fprintf(d.w, "satnogs: dumping request: %v\n", err)
+		return
+	}
+	d.w.Write(dump)
+	fmt.Fprintln(d.w)
+}
+
+// dumpResponse writes resp and replaces its body with a buffered copy so the
+// caller can still read it in full.
+func (d *debugLogger) dumpResponse(resp *http.Response, err error) {
+	if err != nil {
+		d.mu.Lock()
+		fmt.Fprintf(d.w, "satnogs: request failed: %v\n\n", err)
+		d.mu.Unlock()
+		return
+	}
+	head, dumpErr := httputil.DumpResponse(resp, false)
+	var body []byte
+	if dumpErr == nil {
+		body, dumpErr = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if dumpErr != nil {
+		fmt.Fprintf(d.w, "satnogs: dumping response: %v\n", dumpErr)
+		return
+	}
+	d.w.Write(head)
+	if d.bodyLimit >= 0 && len(body) > d.bodyLimit {
+		d.w.Write(body[:d.bodyLimit])
+		fmt.Fprintf(d.w, "\n... (%d bytes truncated)", len(body)-d.bodyLimit)
+	} else {
+		d.w.Write(body)
+	}
+	fmt.Fprint(d.w, "\n\n")
+}
@@ -0,0 +1,44 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDebugBodyLimit(t *testing.T) {
+	body := `{"count":0,"results":[],"padding":"` + strings.Repeat("x", 100) + `"}`
+	handler := func(w http.ResponseWriter, r *http.Request) { writeJSON(w, body) }
+
+	t.Run("without WithDebug", func(t *testing.T) {
+		c, _ := newTestClient(t, handler, WithDebugBodyLimit(10))
+		if c.debug != nil {
+			t.Fatal("WithDebugBodyLimit alone enabled debug logging")
+		}
+		if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	orders := map[string]func(w *bytes.Buffer) []Option{
+		"limit first": func(w *bytes.Buffer) []Option { return []Option{WithDebugBodyLimit(10), WithDebug(w)} },
+		"limit last":  func(w *bytes.Buffer) []Option { return []Option{WithDebug(w), WithDebugBodyLimit(10)} },
+	}
+	for name, opts := range orders {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			c, _ := newTestClient(t, handler, opts(&out)...)
+			if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+				t.Fatal(err)
+			}
+			dump := out.String()
+			if !strings.Contains(dump, body[:10]+"\n... (") {
+				t.Errorf("dump does not truncate the body to 10 bytes:\n%s", dump)
+			}
+			if strings.Contains(dump, "test-key") {
+				t.Errorf("dump leaks the API key:\n%s", dump)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package gosatnogs
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FrameDecoder turns a raw telemetry frame into named fields.
+type FrameDecoder func(frame []byte) (map[string]interface{}, error)
+
+// ErrNoDecoder is returned by DecoderRegistry.DecodeFrame when no decoder is
+// registered for the record's satellite.
+var ErrNoDecoder = errors.New("satnogs: no decoder registered")
+
+// DecoderRegistry maps satellites to the decoders for their frames. It is safe
+// for concurrent use. Two decoders for common link-layer formats are provided
+// as starting points: DecodeAX25, e.g. for the ISS APRS digipeater
+// (NORAD 25544), and DecodeCSP for satellites speaking the CubeSat Space
+// Protocol.
+type DecoderRegistry struct {
+	mu      sync.RWMutex
+	bySatID map[string]FrameDecoder
+	byNorad map[int]FrameDecoder
+}
+
+// NewDecoderRegistry returns an empty DecoderRegistry.
+func NewDecoderRegistry() *DecoderRegistry {
+	return &DecoderRegistry{
+		bySatID: make(map[string]FrameDecoder),
+		byNorad: make(map[int]FrameDecoder),
+	}
+}
+
+// Register sets the decoder for the satellite with the given SatNOGS sat_id.
+func (r *DecoderRegistry) Register(satID string, fn FrameDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bySatID[satID] = fn
+}
+
+// RegisterNoradID sets the decoder for the satellite with the given NORAD ID.
+func (r *DecoderRegistry) RegisterNoradID(noradID int, fn FrameDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byNorad[noradID] = fn
+}
+
+// DecodeFrame decodes t's frame with the decoder registered for its sat_id,
+// or failing that its NORAD ID. It returns ErrNoDecoder if neither has one.
+func (r *DecoderRegistry) DecodeFrame(t Telemetry) (map[string]interface{}, error) {
+	r.mu.RLock()
+	fn, ok := r.bySatID[t.SatID]
+	if !ok {
+		fn, ok = r.byNorad[t.NoradCatID]
+	}
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w for sat_id %q / NORAD ID %d", ErrNoDecoder, t.SatID, t.NoradCatID)
+	}
+	frame, err := t.FrameBytes()
+	if err != nil {
+		return nil, err
+	}
+	return fn(frame)
+}
+
+// DecodeAX25 decodes the header of an AX.25 UI frame (without flags or FCS),
+// returning the "destination", "source" and "path" callsigns with SSIDs,
+// the "control" and "pid" bytes and the "info" field as hex.
+func DecodeAX25(frame []byte) (map[string]interface{}, error) {
+	var addrs []string
+	i := 0
+	for {
+		if len(frame) < i+7 {
+			return nil, errors.New("satnogs: AX.25 frame too short for address field")
+		}
+		addrs = append(addrs, ax25Address(frame[i:i+7]))
+		last := frame[i+6]&0x01 != 0
+		i += 7
+		if last {
+			break
+		}
+	}
+	if len(addrs) < 2 {
+		return nil, errors.New("satnogs: AX.25 frame has fewer than two addresses")
+	}
+	if len(frame) < i+2 {
+		return nil, errors.New("satnogs: AX.25 frame too short for control and PID")
+	}
+	return map[string]interface{}{
+		"destination": addrs[0],
+		"source":      addrs[1],
+		"path":        addrs[2:],
+		"control":     frame[i],
+		"pid":         frame[i+1],
+		"info":        hex.EncodeToString(frame[i+2:]),
+	}, nil
+}
+
+// ax25Address decodes a 7-byte AX.25 address into "CALL-SSID" form.
+func ax25Address(b []byte) string {
+	var call strings.Builder
+	for _, c := range b[:6] {
+		call.WriteByte(c >> 1)
+	}
+	s := strings.TrimRight(call.String(), " ")
+	if ssid := (b[6] >> 1) & 0x0f; ssid != 0 {
+		s += fmt.Sprintf("-%d", ssid)
+	}
+	return s
+}
+
+// DecodeCSP decodes a CubeSat Space Protocol (version 1) header, returning
+// "priority", "source", "destination", "destination_port", "source_port",
+// the "hmac", "xtea", "rdp" and "crc" flags, and the "payload" as hex.
+func DecodeCSP(frame []byte) (map[string]interface{}, error) {
+	if len(frame) < 4 {
+		return nil, errors.New("satnogs: CSP frame too short for header")
+	}
+	h := binary.BigEndian.Uint32(frame)
+	return map[string]interface{}{
+		"priority":         int(h >> 30 & 0x03),
+		"source":           int(h >> 25 & 0x1f),
+		"destination":      int(h >> 20 & 0x1f),
+		"destination_port": int(h >> 14 & 0x3f),
+		"source_port":      int(h >> 8 & 0x3f),
+		"hmac":             h&0x08 != 0,
+		"xtea":             h&0x04 != 0,
+		"rdp":              h&0x02 != 0,
+		"crc":              h&0x01 != 0,
+		"payload":          hex.EncodeToString(frame[4:]),
+	}, nil
+}
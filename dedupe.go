@@ -0,0 +1,63 @@
+package gosatnogs
+
+import (
+	"slices"
+	"time"
+)
+
+// frameKey identifies a downlinked frame independently of who received it.
+type frameKey struct {
+	frame     string
+	timestamp time.Time
+}
+
+func keyOf(t Telemetry) frameKey {
+	return frameKey{frame: t.Frame, timestamp: t.Timestamp.UTC()}
+}
+
+// DedupeTelemetry collapses records with the same Frame and Timestamp, as
+// produced when several ground stations upload the same downlink, keeping the
+// first of each in the original order. Use MergeTelemetry to also learn which
+// observers received each frame.
+func DedupeTelemetry(records []Telemetry) []Telemetry {
+	seen := make(map[frameKey]bool, len(records))
+	out := make([]Telemetry, 0, len(records))
+	for _, t := range records {
+		k := keyOf(t)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// MergedTelemetry is a frame received by one or more observers.
+type MergedTelemetry struct {
+	// Telemetry is the first record of the frame.
+	Telemetry
+	// Observers lists every distinct observer that uploaded the frame, in
+	// the order they appear in the input.
+	Observers []string
+}
+
+// MergeTelemetry is like DedupeTelemetry but keeps the observers of each
+// collapsed frame.
+func MergeTelemetry(records []Telemetry) []MergedTelemetry {
+	index := make(map[frameKey]int, len(records))
+	var out []MergedTelemetry
+	for _, t := range records {
+		k := keyOf(t)
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(out)
+			out = append(out, MergedTelemetry{Telemetry: t, Observers: []string{t.Observer}})
+			continue
+		}
+		if m := &out[i]; !slices.Contains(m.Observers, t.Observer) {
+			m.Observers = append(m.Observers, t.Observer)
+		}
+	}
+	return out
+}
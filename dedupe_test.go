@@ -0,0 +1,80 @@
+package gosatnogs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var dedupeT0 = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func frameAt(frame string, offset time.Duration, observer string) Telemetry {
+	return Telemetry{Frame: frame, Timestamp: Timestamp{dedupeT0.Add(offset)}, Observer: observer}
+}
+
+// dedupeTests describe a kept record as "frame@offset/observer", and a merged
+// one with all its observers joined by commas.
+var dedupeTests = []struct {
+	name       string
+	records    []Telemetry
+	wantDedupe string
+	wantMerge  string
+}{
+	{"empty", nil, "", ""},
+	{
+		"no duplicates",
+		[]Telemetry{frameAt("AA", 0, "N0CALL"), frameAt("BB", 0, "N0CALL"), frameAt("AA", time.Second, "N0CALL")},
+		"AA@0s/N0CALL BB@0s/N0CALL AA@1s/N0CALL",
+		"AA@0s/N0CALL BB@0s/N0CALL AA@1s/N0CALL",
+	},
+	{
+		"several observers",
+		[]Telemetry{frameAt("AA", 0, "N0CALL"), frameAt("BB", 0, "K1ABC"), frameAt("AA", 0, "K1ABC"), frameAt("AA", 0, "DL0XYZ")},
+		"AA@0s/N0CALL BB@0s/K1ABC",
+		"AA@0s/N0CALL,K1ABC,DL0XYZ BB@0s/K1ABC",
+	},
+	{
+		"same observer twice",
+		[]Telemetry{frameAt("AA", 0, "N0CALL"), frameAt("AA", 0, "N0CALL")},
+		"AA@0s/N0CALL",
+		"AA@0s/N0CALL",
+	},
+	{
+		"time zones",
+		[]Telemetry{
+			frameAt("AA", 0, "N0CALL"),
+			{Frame: "AA", Timestamp: Timestamp{dedupeT0.In(time.FixedZone("CEST", 2*60*60))}, Observer: "DL0XYZ"},
+		},
+		"AA@0s/N0CALL",
+		"AA@0s/N0CALL,DL0XYZ",
+	},
+}
+
+func describeFrame(t Telemetry, observers []string) string {
+	return fmt.Sprintf("%s@%v/%s", t.Frame, t.Timestamp.Sub(dedupeT0), strings.Join(observers, ","))
+}
+
+func TestDedupeTelemetry(t *testing.T) {
+	for _, tt := range dedupeTests {
+		var got []string
+		for _, r := range DedupeTelemetry(tt.records) {
+			got = append(got, describeFrame(r, []string{r.Observer}))
+		}
+		if s := strings.Join(got, " "); s != tt.wantDedupe {
+			t.Errorf("%s: DedupeTelemetry = %s, want %s", tt.name, s, tt.wantDedupe)
+		}
+	}
+}
+
+func TestMergeTelemetry(t *testing.T) {
+	for _, tt := range dedupeTests {
+		var got []string
+		for _, m := range MergeTelemetry(tt.records) {
+			got = append(got, describeFrame(m.Telemetry, m.Observers))
+		}
+		if s := strings.Join(got, " "); s != tt.wantMerge {
+			t.Errorf("%s: MergeTelemetry = %s, want %s", tt.name, s, tt.wantMerge)
+		}
+	}
+}
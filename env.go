@@ -0,0 +1,50 @@
+package gosatnogs
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	EnvAPIKey  = "SATNOGS_API_KEY"
+	EnvDBURL   = "SATNOGS_DB_URL"
+	EnvTimeout = "SATNOGS_TIMEOUT"
+)
+
+// NewClientFromEnv is like New but takes its configuration from the
+// environment: the API key from SATNOGS_API_KEY, the base URL from
+// SATNOGS_DB_URL and the request timeout from SATNOGS_TIMEOUT, given either as
+// a Go duration ("30s") or a number of seconds. Unset variables keep the
+// defaults. opts are applied after the environment, so explicit options win.
+// Malformed values are reported as errors.
+func NewClientFromEnv(opts ...Option) (*Client, error) {
+	var envOpts []Option
+	if v := os.Getenv(EnvDBURL); v != "" {
+		envOpts = append(envOpts, WithBaseURL(v))
+	}
+	if v := os.Getenv(EnvTimeout); v != "" {
+		d, err := parseEnvDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("satnogs: invalid %s %q: %w", EnvTimeout, v, err)
+		}
+		envOpts = append(envOpts, WithTimeout(d))
+	}
+	return New(os.Getenv(EnvAPIKey), append(envOpts, opts...)...)
+}
+
+func parseEnvDuration(v string) (time.Duration, error) {
+	if secs, err := strconv.Atoi(v); err == nil {
+		v = strconv.Itoa(secs) + "s"
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration")
+	}
+	return d, nil
+}
@@ -0,0 +1,61 @@
+package gosatnogs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewClientFromEnv(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		key, url, timeout string
+		opts              []Option
+		wantKey, wantURL  string
+		wantTimeout       time.Duration
+		wantErr           bool
+	}{
+		{name: "unset", wantURL: DBBaseURL, wantTimeout: defaultTimeout},
+		{
+			name: "key and url", key: "env-key", url: "https://mirror.example.org/api/",
+			wantKey: "env-key", wantURL: "https://mirror.example.org/api", wantTimeout: defaultTimeout,
+		},
+		{name: "duration", timeout: "1m30s", wantURL: DBBaseURL, wantTimeout: 90 * time.Second},
+		{name: "seconds", timeout: "45", wantURL: DBBaseURL, wantTimeout: 45 * time.Second},
+		{name: "zero seconds", timeout: "0", wantURL: DBBaseURL},
+		{name: "malformed timeout", timeout: "soon", wantErr: true},
+		{name: "negative timeout", timeout: "-5s", wantErr: true},
+		{name: "negative seconds", timeout: "-5", wantErr: true},
+		{name: "malformed url", url: "://nowhere", wantErr: true},
+		{
+			name: "explicit options win", key: "env-key", url: "https://mirror.example.org/api", timeout: "5s",
+			opts:    []Option{WithAPIKey("explicit-key"), WithBaseURL("https://other.example.org/api"), WithTimeout(time.Minute)},
+			wantKey: "explicit-key", wantURL: "https://other.example.org/api", wantTimeout: time.Minute,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvAPIKey, tt.key)
+			t.Setenv(EnvDBURL, tt.url)
+			t.Setenv(EnvTimeout, tt.timeout)
+
+			c, err := NewClientFromEnv(tt.opts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("NewClientFromEnv accepted the environment")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.key() != tt.wantKey {
+				t.Errorf("key = %q, want %q", c.key(), tt.wantKey)
+			}
+			if c.baseURL != tt.wantURL {
+				t.Errorf("base URL = %q, want %q", c.baseURL, tt.wantURL)
+			}
+			if c.client.Timeout != tt.wantTimeout {
+				t.Errorf("timeout = %v, want %v", c.client.Timeout, tt.wantTimeout)
+			}
+		})
+	}
+}
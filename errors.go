@@ -0,0 +1,234 @@
+package gosatnogs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotFound matches any *APIError with a 404 status via errors.Is, e.g. when
+// a satellite or record does not exist.
+var ErrNotFound = errors.New("satnogs: not found")
+
+// ErrMaxPages is returned by auto-paginating helpers when more pages exist
+// than the limit set with WithMaxPages.
+var ErrMaxPages = errors.New("satnogs: page limit reached")
+
+// ErrNoMorePages is returned by FetchNext and FetchPrev when there is no page
+// in the requested direction.
+var ErrNoMorePages = errors.New("satnogs: no more pages")
+
+// ErrNoTelemetry is returned by single-record telemetry helpers when the
+// satellite has no telemetry.
+var ErrNoTelemetry = errors.New("satnogs: no telemetry")
+
+// ErrNoObservation is returned by Telemetry.Observation when the record is
+// not linked to a network observation.
+var ErrNoObservation = errors.New("satnogs: telemetry has no observation")
+
+// ErrSatelliteNotFound is returned by single-satellite helpers when the DB
+// does not know the requested satellite. It is wrapped together with the
+// underlying error, which matches ErrNotFound.
+var ErrSatelliteNotFound = errors.New("satnogs: satellite not found")
+
+// ErrForeignURL is returned when a page URL does not belong to the client's
+// configured API host. Such URLs are rejected before any request is sent so
+// the API key cannot leak to another server.
+var ErrForeignURL = errors.New("satnogs: URL outside configured API host")
+
+// ErrUnauthorized matches any *APIError with a 401 status via errors.Is,
+// meaning the API key is missing, wrong or expired.
+var ErrUnauthorized = errors.New("satnogs: unauthorized")
+
+// ErrForbidden matches any *APIError with a 403 status via errors.Is, meaning
+// the API key is valid but not allowed to access the resource.
+var ErrForbidden = errors.New("satnogs: forbidden")
+
+// ErrServer matches any *APIError with a 5xx status via errors.Is.
+var ErrServer = errors.New("satnogs: server error")
+
+// ErrNotModified matches any *APIError with a 304 status via errors.Is, as
+// returned by conditional requests such as GetTLEIfChanged when the resource
+// is unchanged.
+var ErrNotModified = errors.New("satnogs: not modified")
+
+// ErrNoAPIKey is returned by VerifyAPIKey when the client has no API key.
+var ErrNoAPIKey = errors.New("satnogs: no API key configured")
+
+// ErrRateLimited matches any *APIError with a 429 status via errors.Is. The
+// APIError's RetryAfter field holds the delay requested by the server.
+var ErrRateLimited = errors.New("satnogs: rate limited")
+
+// maxErrorBody bounds how much of an error response body is kept on an APIError.
+const maxErrorBody = 64 << 10
+
+// maxErrorSnippet bounds how much of the body is quoted in APIError.Error.
+const maxErrorSnippet = 200
+
+// APIError is returned when the SatNOGS API answers with a status code outside
+// the 2xx range. Use errors.As to inspect it.
+//
+// When the body is a Django REST Framework error payload, Detail and
+// FieldErrors are populated from it; otherwise only Body is set.
+type APIError struct {
+	StatusCode int
+	// Endpoint is the request path relative to the client's base URL,
+	// e.g. "/telemetry/".
+	Endpoint string
+	URL      string
+	Body     []byte
+
+	// Detail is the "detail" message of the error payload, e.g. "Invalid token.".
+	Detail string
+	// FieldErrors maps query parameter (or "non_field_errors") names to the
+	// validation messages reported for them.
+	FieldErrors map[string][]string
+
+	// RetryAfter is the delay requested by the Retry-After header, or zero
+	// if the response had none.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("satnogs: %s returned %d %s", e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+	switch {
+	case e.Detail != "":
+		msg += ": " + e.Detail
+	case len(e.FieldErrors) > 0:
+		fields := make([]string, 0, len(e.FieldErrors))
+		for field := range e.FieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		msg += ":"
+		for i, field := range fields {
+			if i > 0 {
+				msg += ";"
+			}
+			msg += fmt.Sprintf(" %s: %s", field, strings.Join(e.FieldErrors[field], " "))
+		}
+	default:
+		if snippet := e.snippet(); snippet != "" {
+			msg += ": " + snippet
+		}
+	}
+	return msg
+}
+
+// Is reports whether e matches one of the package's sentinel errors. It lets
+// errors.Is(err, ErrNotFound) and friends work through any wrapping the
+// library or the caller adds, since fmt.Errorf's %w preserves the chain.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotModified:
+		return e.StatusCode == http.StatusNotModified
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrServer:
+		return e.StatusCode >= 500 && e.StatusCode <= 599
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+func (e *APIError) snippet() string {
+	s := strings.TrimSpace(string(e.Body))
+	if len(s) > maxErrorSnippet {
+		s = s[:maxErrorSnippet] + "..."
+	}
+	return s
+}
+
+// checkResponse returns nil for 2xx responses. Otherwise it consumes and closes
+// the response body and returns an *APIError describing the failure of req.
+// The outgoing request is used rather than resp.Request, which transports and
+// middleware are free to leave unset.
+func (c *Client) checkResponse(req *http.Request, resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Endpoint:   c.endpoint(req.URL),
+		URL:        req.URL.String(),
+		Body:       body,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), c.clock.Now()),
+	}
+	apiErr.parseBody()
+	return apiErr
+}
+
+// parseBody fills Detail and FieldErrors from a DRF error payload. Bodies in
+// any other shape are left in Body only.
+func (e *APIError) parseBody() {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(e.Body, &payload); err != nil {
+		return
+	}
+	for key, raw := range payload {
+		if key == "detail" {
+			var detail string
+			if json.Unmarshal(raw, &detail) == nil {
+				e.Detail = detail
+			}
+			continue
+		}
+		var msgs []string
+		if json.Unmarshal(raw, &msgs) != nil {
+			var msg string
+			if json.Unmarshal(raw, &msg) != nil {
+				continue
+			}
+			msgs = []string{msg}
+		}
+		if e.FieldErrors == nil {
+			e.FieldErrors = make(map[string][]string)
+		}
+		e.FieldErrors[key] = msgs
+	}
+}
+
+// endpoint returns the path of u relative to the client's base URL, or to
+// the fallback base URL it belongs to.
+func (c *Client) endpoint(u *url.URL) string {
+	if _, rest := c.matchBase(u); rest != nil {
+		return rest.Path
+	}
+	return u.Path
+}
+
+// parseRetryAfter interprets a Retry-After header value, which is either a
+// number of seconds or an HTTP date. It returns zero for missing or malformed
+// values and for dates in the past.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
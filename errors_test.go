@@ -0,0 +1,151 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCheckResponseWithoutResponseRequest(t *testing.T) {
+	transport := RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+		// A bare response, as some transports and middleware return, with
+		// no Request attached.
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("down for maintenance")),
+		}, nil
+	})
+	c, err := New("", WithBaseURL("https://db.example.org/api"), WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetTelemetry("AAAA-0000")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("StatusCode = %d, want 503", apiErr.StatusCode)
+	}
+	if apiErr.Endpoint != "/telemetry/" {
+		t.Errorf("Endpoint = %q, want /telemetry/", apiErr.Endpoint)
+	}
+	if !strings.HasPrefix(apiErr.URL, "https://db.example.org/api/telemetry/?") {
+		t.Errorf("URL = %q, want the telemetry request URL", apiErr.URL)
+	}
+	if !errors.Is(err, ErrServer) {
+		t.Errorf("errors.Is(err, ErrServer) = false for %v", err)
+	}
+}
+
+func TestStatusSentinels(t *testing.T) {
+	var status atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(status.Load()))
+		w.Write([]byte(`{"detail":"nope"}`))
+	})
+	ctx := context.Background()
+	methods := map[string]func() error{
+		"GetTelemetry":    func() error { _, err := c.GetTelemetry("AAAA-0000"); return err },
+		"GetSatellite":    func() error { _, err := c.GetSatellite("AAAA-0000"); return err },
+		"GetSatellites":   func() error { _, err := c.GetSatellites(SatelliteFilter{}); return err },
+		"GetTransmitters": func() error { _, err := c.GetTransmitters("AAAA-0000"); return err },
+		"GetModes":        func() error { _, err := c.GetModes(); return err },
+		"GetTLE":          func() error { _, err := c.GetTLE(25544); return err },
+		"GetObservation":  func() error { _, err := c.GetObservation(ctx, 1); return err },
+		"GetStations":     func() error { _, err := c.GetStations(StationFilter{}); return err },
+	}
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusNotModified, ErrNotModified},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServer},
+		{http.StatusBadGateway, ErrServer},
+		{http.StatusServiceUnavailable, ErrServer},
+	}
+	for _, tt := range tests {
+		status.Store(int32(tt.status))
+		for name, call := range methods {
+			err := call()
+			if !errors.Is(err, tt.want) {
+				t.Errorf("%s with status %d: err = %v, want %v", name, tt.status, err, tt.want)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Errorf("%s with status %d: err = %v, want an *APIError with that status", name, tt.status, err)
+			}
+		}
+	}
+}
+
+func TestAPIErrorDRFBodies(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		detail      string
+		fieldErrors map[string][]string
+		message     string
+	}{
+		{
+			name:    "detail",
+			status:  http.StatusUnauthorized,
+			body:    `{"detail":"Invalid token."}`,
+			detail:  "Invalid token.",
+			message: "satnogs: /telemetry/ returned 401 Unauthorized: Invalid token.",
+		},
+		{
+			name:   "field errors",
+			status: http.StatusBadRequest,
+			body:   `{"start":["Enter a valid date/time."],"non_field_errors":"Bad range."}`,
+			fieldErrors: map[string][]string{
+				"start":            {"Enter a valid date/time."},
+				"non_field_errors": {"Bad range."},
+			},
+			message: "satnogs: /telemetry/ returned 400 Bad Request: non_field_errors: Bad range.; start: Enter a valid date/time.",
+		},
+		{
+			name:    "not JSON",
+			status:  http.StatusBadGateway,
+			body:    "<html>Bad Gateway</html>",
+			message: "satnogs: /telemetry/ returned 502 Bad Gateway: <html>Bad Gateway</html>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+			_, err := c.GetTelemetry("AAAA-0000")
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("err = %v, want *APIError", err)
+			}
+			if apiErr.Detail != tt.detail {
+				t.Errorf("Detail = %q, want %q", apiErr.Detail, tt.detail)
+			}
+			if !reflect.DeepEqual(apiErr.FieldErrors, tt.fieldErrors) {
+				t.Errorf("FieldErrors = %v, want %v", apiErr.FieldErrors, tt.fieldErrors)
+			}
+			if string(apiErr.Body) != tt.body {
+				t.Errorf("Body = %q, want %q", apiErr.Body, tt.body)
+			}
+			if err.Error() != tt.message {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.message)
+			}
+		})
+	}
+}
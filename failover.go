@@ -0,0 +1,131 @@
+package gosatnogs
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// failoverReprobe is how long the client sticks to a fallback before trying
+// the primary base URL again.
+const failoverReprobe = 5 * time.Minute
+
+// failoverState remembers which base URL last answered successfully.
+type failoverState struct {
+	mu         sync.Mutex
+	active     int
+	switchedAt time.Time
+}
+
+// WithFallbackBaseURLs adds mirrors of the API to fail over to. When a
+// request to the current base URL fails with a connection error or a 5xx
+// status, the same path and query are tried against the next URL in order;
+// 4xx responses are returned as they are. The client keeps using the last
+// URL that answered and re-probes the primary every five minutes.
+//
+// Pagination links returned by one host are rewritten onto whichever base URL
+// the request is sent to, so Next and Prev keep working after a failover.
+func WithFallbackBaseURLs(urls ...string) Option {
+	return func(c *Client) error {
+		for _, raw := range urls {
+			base, err := parseBaseURL(raw)
+			if err != nil {
+				return err
+			}
+			c.fallbacks = append(c.fallbacks, base)
+		}
+		return nil
+	}
+}
+
+// bases returns the primary base URL followed by the fallbacks.
+func (c *Client) bases() []string {
+	return append([]string{c.baseURL}, c.fallbacks...)
+}
+
+// matchBase finds the base URL u lies under. It returns the index of that
+// base and u relative to it, or -1 and nil if u belongs to none.
+func (c *Client) matchBase(u *url.URL) (int, *url.URL) {
+	for i, b := range c.bases() {
+		base, err := url.Parse(b)
+		if err != nil || u.Host != base.Host {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(u.Path, base.Path); ok {
+			rel := *u
+			rel.Scheme, rel.Host, rel.Path, rel.RawPath = "", "", rest, ""
+			return i, &rel
+		}
+	}
+	return -1, nil
+}
+
+// preferred returns the index of the base URL to try first.
+func (f *failoverState) preferred(now time.Time) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active != 0 && now.Sub(f.switchedAt) >= failoverReprobe {
+		f.active = 0
+	}
+	return f.active
+}
+
+func (f *failoverState) succeeded(i int, now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active != i {
+		f.active, f.switchedAt = i, now
+	}
+}
+
+// doFailover sends req through do, moving on to the fallback base URLs when
+// the preferred one fails with a connection error or a 5xx status.
+func (c *Client) doFailover(req *http.Request) (*http.Response, error) {
+	if len(c.fallbacks) == 0 {
+		return c.do(req)
+	}
+	_, rel := c.matchBase(req.URL)
+	if rel == nil {
+		return c.do(req)
+	}
+
+	bases := c.bases()
+	start := c.failover.preferred(c.clock.Now())
+	var lastErr error
+	for n := range bases {
+		i := (start + n) % len(bases)
+		u, err := url.Parse(bases[i] + rel.Path)
+		if err != nil {
+			return nil, err
+		}
+		u.RawQuery = rel.RawQuery
+
+		r := req.Clone(req.Context())
+		r.URL, r.Host = u, ""
+		resp, err := c.do(r)
+		if err == nil {
+			c.failover.succeeded(i, c.clock.Now())
+			return resp, nil
+		}
+		if !failoverable(req, err) {
+			return nil, err
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// failoverable reports whether err warrants trying another base URL.
+func failoverable(req *http.Request, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return isNetworkError(err)
+}
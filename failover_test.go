@@ -0,0 +1,172 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failoverServers starts a primary answering with the status in primaryStatus
+// (or a page when it is 200) and a healthy fallback mounted under /mirror.
+// Both count their requests.
+type failoverServers struct {
+	primary, fallback         *httptest.Server
+	primaryHits, fallbackHits atomic.Int32
+	primaryStatus             atomic.Int32
+	fallbackNext              string
+}
+
+func newFailoverServers(t *testing.T) *failoverServers {
+	t.Helper()
+	f := &failoverServers{}
+	f.primaryStatus.Store(http.StatusServiceUnavailable)
+	f.primary = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.primaryHits.Add(1)
+		if status := int(f.primaryStatus.Load()); status != http.StatusOK {
+			http.Error(w, "primary", status)
+			return
+		}
+		writeJSON(w, `{"count":1,"results":[{"sat_id":"PRIMARY"}]}`)
+	}))
+	f.fallback = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.fallbackHits.Add(1)
+		if !strings.HasPrefix(r.URL.Path, "/mirror/") {
+			http.NotFound(w, r)
+			return
+		}
+		next := "null"
+		if f.fallbackNext != "" && r.URL.Query().Get("page") == "" {
+			next = `"` + f.fallbackNext + `"`
+		}
+		writeJSON(w, `{"count":1,"next":`+next+`,"results":[{"sat_id":"FALLBACK"}]}`)
+	}))
+	t.Cleanup(f.primary.Close)
+	t.Cleanup(f.fallback.Close)
+	return f
+}
+
+func (f *failoverServers) client(t *testing.T, primary string, opts ...Option) *Client {
+	t.Helper()
+	opts = append([]Option{WithBaseURL(primary), WithFallbackBaseURLs(f.fallback.URL + "/mirror")}, opts...)
+	c, err := New("", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func (f *failoverServers) hits() [2]int32 {
+	return [2]int32{f.primaryHits.Load(), f.fallbackHits.Load()}
+}
+
+func satID(t *testing.T, c *Client) string {
+	t.Helper()
+	got, err := c.GetTelemetry("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got[0].SatID
+}
+
+func TestFailoverOn5xxAndRemember(t *testing.T) {
+	f := newFailoverServers(t)
+	clk := newFakeClock()
+	c := f.client(t, f.primary.URL, withClock(clk))
+
+	if id := satID(t, c); id != "FALLBACK" {
+		t.Errorf("served by %s, want FALLBACK", id)
+	}
+	if h := f.hits(); h != [2]int32{1, 1} {
+		t.Errorf("hits = %v, want primary then fallback", h)
+	}
+
+	// The fallback is remembered, even once the primary recovers.
+	f.primaryStatus.Store(http.StatusOK)
+	clk.Advance(failoverReprobe - time.Second)
+	if id := satID(t, c); id != "FALLBACK" {
+		t.Errorf("served by %s, want the remembered FALLBACK", id)
+	}
+	if h := f.hits(); h != [2]int32{1, 2} {
+		t.Errorf("hits = %v, want only the fallback asked", h)
+	}
+
+	// After the re-probe interval the primary is tried again.
+	clk.Advance(time.Second)
+	if id := satID(t, c); id != "PRIMARY" {
+		t.Errorf("served by %s after the re-probe interval, want PRIMARY", id)
+	}
+	if h := f.hits(); h != [2]int32{2, 2} {
+		t.Errorf("hits = %v, want the primary re-probed", h)
+	}
+}
+
+func TestFailoverOnConnectionError(t *testing.T) {
+	f := newFailoverServers(t)
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+	c := f.client(t, dead.URL)
+
+	if id := satID(t, c); id != "FALLBACK" {
+		t.Errorf("served by %s, want FALLBACK", id)
+	}
+}
+
+func TestNoFailoverOn4xx(t *testing.T) {
+	f := newFailoverServers(t)
+	f.primaryStatus.Store(http.StatusNotFound)
+	c := f.client(t, f.primary.URL)
+
+	if _, err := c.GetTelemetry("AAAA-0000"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want the primary's 404", err)
+	}
+	if h := f.hits(); h != [2]int32{1, 0} {
+		t.Errorf("hits = %v, want the fallback left alone", h)
+	}
+}
+
+func TestFailoverRewritesPageLinks(t *testing.T) {
+	f := newFailoverServers(t)
+	f.fallbackNext = "https://db.satnogs.org/api/telemetry/?format=json&page=2"
+	c := f.client(t, f.primary.URL)
+
+	page, err := c.GetTelemetryResponse("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := f.fallback.URL + "/mirror/telemetry/?format=json&page=2"; page.Next != want {
+		t.Errorf("Next = %q, want %q", page.Next, want)
+	}
+	if _, err := NextPage(context.Background(), c, page); err != nil {
+		t.Fatal(err)
+	}
+	if h := f.hits(); h != [2]int32{1, 2} {
+		t.Errorf("hits = %v, want the next page from the fallback", h)
+	}
+}
+
+func TestPingFailsOver(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+	var fallbackHits atomic.Int32
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackHits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fallback.Close()
+
+	c, err := New("", WithBaseURL(dead.URL), WithFallbackBaseURLs(fallback.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if n := fallbackHits.Load(); n != 1 {
+		t.Errorf("fallback saw %d requests, want 1", n)
+	}
+}
@@ -0,0 +1,67 @@
+package gosatnogs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileCache is a Cache that keeps each response in a pair of files under a
+// directory, so cached data survives process restarts. Write errors are
+// ignored: a response that cannot be stored is simply fetched again next time.
+type FileCache struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+// NewFileCache returns a FileCache storing its files in dir, creating the
+// directory if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("satnogs: creating cache directory: %w", err)
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+// paths returns the files holding the body and metadata for key. Keys are
+// hashed to get names that are safe on any filesystem.
+func (fc *FileCache) paths(key string) (body, meta string) {
+	sum := sha256.Sum256([]byte(key))
+	name := filepath.Join(fc.dir, hex.EncodeToString(sum[:]))
+	return name + ".body", name + ".json"
+}
+
+func (fc *FileCache) Get(key string) ([]byte, CacheMetadata, bool) {
+	bodyPath, metaPath := fc.paths(key)
+	fc.mu.RLock()
+	defer fc.mu.RUnlock()
+
+	var meta CacheMetadata
+	raw, err := os.ReadFile(metaPath)
+	if err != nil || json.Unmarshal(raw, &meta) != nil {
+		return nil, CacheMetadata{}, false
+	}
+	body, err := os.ReadFile(bodyPath)
+	if err != nil {
+		return nil, CacheMetadata{}, false
+	}
+	return body, meta, true
+}
+
+func (fc *FileCache) Set(key string, value []byte, meta CacheMetadata) {
+	raw, err := json.Marshal(meta)
+	if err != nil {
+		return
+	}
+	bodyPath, metaPath := fc.paths(key)
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if os.WriteFile(bodyPath, value, 0o600) != nil {
+		return
+	}
+	_ = os.WriteFile(metaPath, raw, 0o600)
+}
@@ -0,0 +1,232 @@
+package gosatnogs
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Orderings accepted in TelemetryFilter.Ordering.
+const (
+	// OrderNewestFirst returns the most recent telemetry first, the API default.
+	OrderNewestFirst = "-timestamp"
+	// OrderOldestFirst returns the oldest telemetry first, e.g. for appending
+	// to a time-series store in order.
+	OrderOldestFirst = "timestamp"
+)
+
+// App sources of telemetry, as reported in Telemetry.AppSource and accepted
+// in TelemetryFilter.AppSource.
+const (
+	// AppSourceNetwork marks frames uploaded by SatNOGS network stations.
+	AppSourceNetwork = "network"
+	// AppSourceSiDS marks frames submitted by third parties through the
+	// SiDS protocol, which may include duplicates and test data.
+	AppSourceSiDS = "sids"
+	// AppSourceManual marks frames added by hand.
+	AppSourceManual = "manual"
+)
+
+// TelemetryFilter narrows the telemetry returned by GetTelemetryFiltered.
+// Zero-valued fields are not sent to the API.
+type TelemetryFilter struct {
+	// Observer is the observer that uploaded the frames, a callsign and
+	// Maidenhead locator such as "N0CALL-EM12". It is sent verbatim, URL
+	// encoded, and can be combined with Start and End. Whether a partial
+	// value matches is up to the server; the filter is carried in the Next
+	// and Prev links, so it holds across pages.
+	Observer string
+	// StationID is the SatNOGS network ground station that received the frames.
+	StationID int
+	// ObservationID is the SatNOGS network observation that produced the
+	// frames, as in Telemetry.ObservationID.
+	ObservationID int
+	// Ordering sorts the results; use OrderNewestFirst or OrderOldestFirst.
+	// The empty string keeps the API's default order. The order is carried
+	// in the Next and Prev links, so it holds across pages.
+	Ordering string
+	// Transmitter is the UUID of the transmitter that sent the frames, as in
+	// Transmitter.UUID and Telemetry.Transmitter, for satellites with
+	// several downlinks.
+	Transmitter string
+	// AppSource is the way the frames reached the DB; use AppSourceNetwork,
+	// AppSourceSiDS or AppSourceManual.
+	AppSource string
+	// Version is the version of the uploading software or protocol, as in
+	// Telemetry.Version, e.g. to isolate frames from one decoder release.
+	// It is passed through verbatim.
+	Version string
+	// Start and End bound the timestamps of the returned telemetry. Either
+	// may be the zero time to leave that side of the range open. They are
+	// sent in UTC regardless of their location.
+	Start time.Time
+	End   time.Time
+}
+
+// validTransmitterUUID reports whether s looks like a SatNOGS transmitter
+// UUID: either a 22 character short UUID such as "2DH5XYBTT3hwWf3vQnvgxC",
+// the form the DB uses, or a canonical 36 character UUID.
+func validTransmitterUUID(s string) bool {
+	switch len(s) {
+	case 22:
+		for _, r := range s {
+			if !('0' <= r && r <= '9' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+				return false
+			}
+		}
+		return true
+	case 36:
+		for i, r := range s {
+			switch i {
+			case 8, 13, 18, 23:
+				if r != '-' {
+					return false
+				}
+			default:
+				if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F') {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// validate rejects field values the API would not accept.
+func (f TelemetryFilter) validate() error {
+	switch f.Ordering {
+	case "", OrderNewestFirst, OrderOldestFirst:
+	default:
+		return fmt.Errorf("satnogs: unsupported ordering %q, want %q or %q", f.Ordering, OrderNewestFirst, OrderOldestFirst)
+	}
+	switch f.AppSource {
+	case "", AppSourceNetwork, AppSourceSiDS, AppSourceManual:
+	default:
+		return fmt.Errorf("satnogs: unsupported app source %q, want %q, %q or %q", f.AppSource, AppSourceNetwork, AppSourceSiDS, AppSourceManual)
+	}
+	if f.StationID < 0 {
+		return fmt.Errorf("satnogs: invalid station ID %d", f.StationID)
+	}
+	if f.ObservationID < 0 {
+		return fmt.Errorf("satnogs: invalid observation ID %d", f.ObservationID)
+	}
+	if f.Transmitter != "" && !validTransmitterUUID(f.Transmitter) {
+		return fmt.Errorf("satnogs: invalid transmitter UUID %q", f.Transmitter)
+	}
+	if !f.Start.IsZero() && !f.End.IsZero() && f.Start.After(f.End) {
+		return fmt.Errorf("satnogs: invalid time range: start %v is after end %v", f.Start, f.End)
+	}
+	return nil
+}
+
+// apply adds the filter's non-zero fields to params.
+func (f TelemetryFilter) apply(params Params) {
+	if f.Observer != "" {
+		params.Set("observer", f.Observer)
+	}
+	if f.StationID != 0 {
+		params.SetInt("station_id", f.StationID)
+	}
+	if f.ObservationID != 0 {
+		params.SetInt("observation_id", f.ObservationID)
+	}
+	if f.Ordering != "" {
+		params.Set("ordering", f.Ordering)
+	}
+	if f.Transmitter != "" {
+		params.Set("transmitter", f.Transmitter)
+	}
+	if f.AppSource != "" {
+		params.Set("app_source", f.AppSource)
+	}
+	if f.Version != "" {
+		params.Set("version", f.Version)
+	}
+	if !f.Start.IsZero() {
+		params.SetTime("start", f.Start)
+	}
+	if !f.End.IsZero() {
+		params.SetTime("end", f.End)
+	}
+}
+
+// GetTelemetryFiltered retrieves the first page of telemetry for a satellite
+// that matches f. Use the pagination helpers on the returned response to
+// fetch further pages. A filter with an unsupported Ordering or a Start after
+// End is rejected without sending a request.
+func (c *Client) GetTelemetryFiltered(satelliteID string, f TelemetryFilter) (*TelemetryResponse, error) {
+	return c.GetTelemetryFilteredContext(context.Background(), satelliteID, f)
+}
+
+// GetTelemetryFilteredContext is like GetTelemetryFiltered but binds the request to ctx.
+func (c *Client) GetTelemetryFilteredContext(ctx context.Context, satelliteID string, f TelemetryFilter) (*TelemetryResponse, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	params := Params{"sat_id": {satelliteID}, "format": {"json"}}
+	f.apply(params)
+	return c.getTelemetryResponse(ctx, params)
+}
+
+// GetTelemetryForTransmitter retrieves the first page of telemetry sent by the
+// transmitter with the given UUID, regardless of satellite, that also matches
+// f. Any Transmitter set in f is replaced. An empty or malformed UUID is
+// rejected without sending a request.
+func (c *Client) GetTelemetryForTransmitter(transmitterUUID string, f TelemetryFilter) (*TelemetryResponse, error) {
+	return c.GetTelemetryForTransmitterContext(context.Background(), transmitterUUID, f)
+}
+
+// GetTelemetryForTransmitterContext is like GetTelemetryForTransmitter but
+// binds the request to ctx.
+func (c *Client) GetTelemetryForTransmitterContext(ctx context.Context, transmitterUUID string, f TelemetryFilter) (*TelemetryResponse, error) {
+	if transmitterUUID == "" {
+		return nil, fmt.Errorf("satnogs: empty transmitter UUID")
+	}
+	f.Transmitter = transmitterUUID
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	params := Params{"format": {"json"}}
+	f.apply(params)
+	return c.getTelemetryResponse(ctx, params)
+}
+
+// GetTelemetryForObservation retrieves the first page of the telemetry
+// produced by the network observation with the given ID.
+func (c *Client) GetTelemetryForObservation(obsID int) (*TelemetryResponse, error) {
+	return c.GetTelemetryForObservationContext(context.Background(), obsID)
+}
+
+// GetTelemetryForObservationContext is like GetTelemetryForObservation but
+// binds the request to ctx.
+func (c *Client) GetTelemetryForObservationContext(ctx context.Context, obsID int) (*TelemetryResponse, error) {
+	if obsID <= 0 {
+		return nil, fmt.Errorf("satnogs: invalid observation ID %d", obsID)
+	}
+	params := Params{"format": {"json"}}
+	TelemetryFilter{ObservationID: obsID}.apply(params)
+	return c.getTelemetryResponse(ctx, params)
+}
+
+// GetTelemetryForStation retrieves the first page of telemetry received by the
+// ground station with the given ID, for every satellite, that also matches f.
+// Any StationID set in f is replaced.
+func (c *Client) GetTelemetryForStation(stationID int, f TelemetryFilter) (*TelemetryResponse, error) {
+	return c.GetTelemetryForStationContext(context.Background(), stationID, f)
+}
+
+// GetTelemetryForStationContext is like GetTelemetryForStation but binds the
+// request to ctx.
+func (c *Client) GetTelemetryForStationContext(ctx context.Context, stationID int, f TelemetryFilter) (*TelemetryResponse, error) {
+	if stationID <= 0 {
+		return nil, fmt.Errorf("satnogs: invalid station ID %d", stationID)
+	}
+	f.StationID = stationID
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	params := Params{"format": {"json"}}
+	f.apply(params)
+	return c.getTelemetryResponse(ctx, params)
+}
@@ -0,0 +1,123 @@
+package gosatnogs
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestObserverFilterAcrossPages(t *testing.T) {
+	const observer = "N0CALL-EM12 /portable&1"
+	var queries []url.Values
+	serve := pagedTelemetry(5)
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		serve(w, r)
+	})
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	page, err := c.GetTelemetryFiltered("AAAA-0000", TelemetryFilter{Observer: observer, Start: start})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := url.Parse(page.Next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := next.Query().Get("observer"); got != observer {
+		t.Errorf("observer in Next = %q, want %q", got, observer)
+	}
+
+	records := len(page.Results)
+	for page.HasNext() {
+		if page, err = NextPage(context.Background(), c, page); err != nil {
+			t.Fatal(err)
+		}
+		records += len(page.Results)
+		for _, rec := range page.Results {
+			if rec.Observer != observer {
+				t.Errorf("record observer = %q, want %q", rec.Observer, observer)
+			}
+		}
+	}
+	if records != 5 {
+		t.Errorf("got %d records, want 5", records)
+	}
+	for i, q := range queries {
+		if q.Get("observer") != observer || q.Get("start") == "" {
+			t.Errorf("request %d query = %v, want the observer and start kept", i, q)
+		}
+	}
+}
+
+func TestNumericFilterEncoding(t *testing.T) {
+	var query string
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		writeJSON(w, `{"count":0,"results":[]}`)
+	})
+	tests := []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{
+			name: "both with sat_id",
+			call: func() error {
+				_, err := c.GetTelemetryFiltered("AAAA-0000", TelemetryFilter{ObservationID: 9411234, StationID: 1361})
+				return err
+			},
+			want: "format=json&observation_id=9411234&sat_id=AAAA-0000&station_id=1361",
+		},
+		{
+			name: "zero means unset",
+			call: func() error {
+				_, err := c.GetTelemetryFiltered("AAAA-0000", TelemetryFilter{})
+				return err
+			},
+			want: "format=json&sat_id=AAAA-0000",
+		},
+		{
+			name: "observation",
+			call: func() error { _, err := c.GetTelemetryForObservation(9411234); return err },
+			want: "format=json&observation_id=9411234",
+		},
+		{
+			name: "station overrides filter",
+			call: func() error {
+				_, err := c.GetTelemetryForStation(1361, TelemetryFilter{StationID: 7, ObservationID: 42})
+				return err
+			},
+			want: "format=json&observation_id=42&station_id=1361",
+		},
+	}
+	for _, tt := range tests {
+		query = ""
+		if err := tt.call(); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if query != tt.want {
+			t.Errorf("%s: query = %q, want %q", tt.name, query, tt.want)
+		}
+	}
+
+	for _, id := range []int{0, -1} {
+		if _, err := c.GetTelemetryForObservation(id); err == nil {
+			t.Errorf("GetTelemetryForObservation(%d) accepted", id)
+		}
+		if _, err := c.GetTelemetryForStation(id, TelemetryFilter{}); err == nil {
+			t.Errorf("GetTelemetryForStation(%d) accepted", id)
+		}
+	}
+	query = ""
+	for _, f := range []TelemetryFilter{{StationID: -1}, {ObservationID: -1}} {
+		if _, err := c.GetTelemetryFiltered("AAAA-0000", f); err == nil {
+			t.Errorf("GetTelemetryFiltered(%+v) accepted", f)
+		}
+	}
+	if query != "" {
+		t.Errorf("negative IDs were sent as %q", query)
+	}
+}
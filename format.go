@@ -0,0 +1,52 @@
+package gosatnogs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Response formats accepted by GetTelemetryFormat.
+const (
+	// FormatJSON is plain JSON, the format every typed method uses.
+	FormatJSON = "json"
+	// FormatAPI is the Django REST Framework browsable API, an HTML page.
+	FormatAPI = "api"
+)
+
+// validFormat reports whether format is one the API serves.
+func validFormat(format string) bool {
+	switch format {
+	case FormatJSON, FormatAPI:
+		return true
+	}
+	return false
+}
+
+// GetTelemetryFormat requests the first page of telemetry for a satellite in
+// format, one of FormatJSON and FormatAPI, and returns the raw response for
+// the caller to read and close. An unknown format is rejected without sending
+// a request.
+func (c *Client) GetTelemetryFormat(ctx context.Context, satelliteID, format string) (*http.Response, error) {
+	if !validFormat(format) {
+		return nil, fmt.Errorf("satnogs: unsupported format %q, want %q or %q", format, FormatJSON, FormatAPI)
+	}
+	return c.GetWithContext(ctx, "/telemetry/", c.telemetryParams(Params{"sat_id": {satelliteID}, "format": {format}}))
+}
+
+// WriteTelemetryFrames writes every telemetry frame of a satellite to w as
+// hex text, one frame per line, newest first, for piping into external
+// decoders. Pages are fetched as they are written, so the history is never
+// held in memory. It returns the number of frames written.
+func (c *Client) WriteTelemetryFrames(ctx context.Context, w io.Writer, satelliteID string) (int, error) {
+	n := 0
+	it := c.IterateTelemetry(ctx, satelliteID)
+	for it.Next() {
+		if _, err := io.WriteString(w, it.Current().Frame+"\n"); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, it.Err()
+}
@@ -0,0 +1,24 @@
+package gosatnogs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetTelemetryFormatPageSize(t *testing.T) {
+	var query string
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte("<html></html>"))
+	}, WithPageSize(50))
+
+	resp, err := c.GetTelemetryFormat(context.Background(), "AAAA-0000", FormatAPI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if want := "format=api&page_size=50&sat_id=AAAA-0000"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+}
@@ -0,0 +1,24 @@
+package gosatnogs
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ErrEmptyFrame is returned by Telemetry.FrameBytes when the record has no frame.
+var ErrEmptyFrame = errors.New("satnogs: telemetry frame is empty")
+
+// FrameBytes decodes the hex-encoded Frame into raw bytes. It returns
+// ErrEmptyFrame if Frame is empty and a descriptive error if it is not valid
+// hex.
+func (t Telemetry) FrameBytes() ([]byte, error) {
+	if t.Frame == "" {
+		return nil, ErrEmptyFrame
+	}
+	b, err := hex.DecodeString(t.Frame)
+	if err != nil {
+		return nil, fmt.Errorf("satnogs: malformed telemetry frame (%d hex chars): %w", len(t.Frame), err)
+	}
+	return b, nil
+}
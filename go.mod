@@ -1,3 +1,8 @@
 module github.com/Alatec/go-satnogs
 
 go 1.23.4
+
+require (
+	golang.org/x/sync v0.10.0
+	golang.org/x/time v0.11.0
+)
@@ -0,0 +1,41 @@
+package gosatnogs
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// gzipBody decompresses a gzip-encoded response body and closes the
+// underlying body along with the decompressor.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (g *gzipBody) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
+
+// decompress replaces the body of a gzip-encoded response with a decompressing
+// reader. Because the client sets Accept-Encoding itself, net/http leaves the
+// body compressed, so this mirrors what the transport would otherwise do.
+func decompress(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return fmt.Errorf("satnogs: reading gzip response: %w", err)
+	}
+	resp.Body = &gzipBody{Reader: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
@@ -0,0 +1,60 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"testing"
+)
+
+func TestGzipResponses(t *testing.T) {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	zw.Write([]byte(fullPage))
+	zw.Close()
+
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+	}{
+		{"gzip", "gzip", compressed.Bytes()},
+		{"plain", "", []byte(fullPage)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var accept string
+			c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				accept = r.Header.Get("Accept-Encoding")
+				if tt.encoding != "" {
+					w.Header().Set("Content-Encoding", tt.encoding)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write(tt.body)
+			})
+			got, err := c.GetTelemetry("AAAA-0000")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || got[0].SatID != "AAAA-0000" {
+				t.Errorf("GetTelemetry = %+v, want the decoded page", got)
+			}
+			if accept != "gzip" {
+				t.Errorf("Accept-Encoding = %q, want gzip", accept)
+			}
+			if s := c.Stats(); s.BytesDownloaded != int64(len(tt.body)) {
+				t.Errorf("BytesDownloaded = %d, want the %d bytes on the wire", s.BytesDownloaded, len(tt.body))
+			}
+		})
+	}
+}
+
+func TestGzipCorruptBody(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write([]byte("not gzip at all"))
+	})
+	if _, err := c.GetTelemetry("AAAA-0000"); err == nil {
+		t.Fatal("corrupt gzip body decoded without error")
+	}
+}
@@ -0,0 +1,102 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// pingTimeout bounds Ping when the caller's context has no earlier deadline.
+const pingTimeout = 5 * time.Second
+
+// ErrUnreachable wraps errors from Ping where no HTTP response was received,
+// such as DNS failures, refused connections or timeouts.
+var ErrUnreachable = errors.New("satnogs: API unreachable")
+
+// Ping checks that the API is reachable and responding by requesting its root
+// without credentials, though with the headers set by WithHeader and
+// WithRequestHeader, failing over to the fallback base URLs like any other
+// request. It returns nil for a 2xx or 3xx answer. If the server
+// could not be reached the error matches ErrUnreachable; if it answered with
+// an error status the error wraps the *APIError.
+func (c *Client) Ping(ctx context.Context) error {
+	if c.err != nil {
+		return c.err
+	}
+	if c.closed.Load() {
+		return ErrClientClosed
+	}
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/", nil)
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req, requestOptionsFrom(ctx))
+	resp, err := c.doFailover(req)
+	var apiErr *APIError
+	switch {
+	case err == nil:
+		resp.Body.Close()
+		return nil
+	case errors.As(err, &apiErr):
+		if apiErr.StatusCode >= 300 && apiErr.StatusCode <= 399 {
+			return nil
+		}
+		return fmt.Errorf("satnogs: API at %s is not healthy: %w", c.baseURL, err)
+	case isNetworkError(err):
+		return fmt.Errorf("%w: %s: %w", ErrUnreachable, c.baseURL, err)
+	default:
+		return err
+	}
+}
+
+// VerifyAPIKey checks the client's API key by making a request to an
+// endpoint that requires authentication. It returns ErrNoAPIKey if no key is
+// set, an error matching ErrUnauthorized if the API rejects the key (401 or
+// 403), and any other failure unchanged.
+func (c *Client) VerifyAPIKey(ctx context.Context) error {
+	if c.err != nil {
+		return c.err
+	}
+	if c.key() == "" {
+		return ErrNoAPIKey
+	}
+	var page TelemetryResponse
+	err := c.getJSON(ctx, "/telemetry/", Params{"format": {"json"}}, &page)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("%w: %w", ErrUnauthorized, err)
+	}
+	return err
+}
+
+// HealthCheck confirms that the API is reachable and, if the client has an API
+// key, that the key is accepted, by fetching a page of telemetry. Without a
+// key it is equivalent to Ping. A rejected key yields an error matching
+// ErrUnauthorized, a network failure one matching ErrUnreachable, and any
+// other error status the *APIError itself.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	if c.key() == "" {
+		return c.Ping(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	err := c.VerifyAPIKey(ctx)
+	if isNetworkError(err) {
+		return fmt.Errorf("%w: %s: %w", ErrUnreachable, c.baseURL, err)
+	}
+	return err
+}
+
+// isNetworkError reports whether err came from the transport, meaning no HTTP
+// response was received.
+func isNetworkError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
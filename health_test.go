@@ -0,0 +1,32 @@
+package gosatnogs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestPingHeaders(t *testing.T) {
+	var auth []string
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		if r.Header.Get("X-Org-Token") != "org-secret" || r.Header.Get("X-Request-ID") != "probe-1" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, WithHeader("X-Org-Token", "org-secret"))
+
+	ctx := WithRequestOptions(context.Background(), WithRequestHeader("X-Request-ID", "probe-1"))
+	if err := c.Ping(ctx); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if err := c.Ping(context.Background()); err == nil {
+		t.Error("Ping without the per-call header succeeded")
+	}
+	for i, a := range auth {
+		if a != "" {
+			t.Errorf("Ping %d sent Authorization %q", i, a)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package gosatnogs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// hedgeConfig controls hedged requests; see WithHedging.
+type hedgeConfig struct {
+	delay    time.Duration
+	maxExtra int
+}
+
+// WithHedging makes the client send a duplicate of a GET attempt that has not
+// completed within delay, up to maxExtra duplicates spaced delay apart. The
+// first response to arrive is used and the other attempts are cancelled.
+// This trades extra load on the API for a lower tail latency, so keep delay
+// near the usual response time and maxExtra small.
+//
+// Duplicates count against the request budget like any other round trip. If
+// a rate limiter is configured and has no token available when a duplicate
+// is due, that duplicate is skipped rather than waited for. Hedging applies to
+// each attempt, so retries are still made as configured when every copy fails.
+// Copies cancelled because another one won are not reported to the metrics
+// recorder, response hooks or debug log.
+func WithHedging(delay time.Duration, maxExtra int) Option {
+	return func(c *Client) error {
+		if delay <= 0 {
+			return fmt.Errorf("satnogs: invalid hedging delay %v", delay)
+		}
+		if maxExtra < 1 {
+			return fmt.Errorf("satnogs: invalid hedging count %d", maxExtra)
+		}
+		c.hedge = hedgeConfig{delay: delay, maxExtra: maxExtra}
+		return nil
+	}
+}
+
+// hedgeResult is the outcome of one copy of a hedged attempt.
+type hedgeResult struct {
+	index int
+	resp  *http.Response
+	err   error
+}
+
+// sendHedged performs one attempt of req through send, hedging it when
+// enabled.
+func (c *Client) sendHedged(req *http.Request, attempt int) (*http.Response, error) {
+	ctx := req.Context()
+	if c.hedge.maxExtra == 0 || !idempotent(req) {
+		return c.send(req.Clone(ctx), attempt)
+	}
+
+	results := make(chan hedgeResult, c.hedge.maxExtra+1)
+	var cancels []context.CancelFunc
+	var abandoned []*atomic.Bool
+	launch := func() {
+		lost := new(atomic.Bool)
+		hctx, cancel := context.WithCancel(context.WithValue(ctx, hedgeAbandonedKey{}, lost))
+		i := len(cancels)
+		cancels = append(cancels, cancel)
+		abandoned = append(abandoned, lost)
+		go func() {
+			resp, err := c.send(req.Clone(hctx), attempt)
+			results <- hedgeResult{index: i, resp: resp, err: err}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(c.hedge.delay)
+	defer timer.Stop()
+	inflight := 1
+	var errs []error
+	for {
+		select {
+		case <-timer.C:
+			if len(cancels) <= c.hedge.maxExtra {
+				if c.limiter == nil || c.limiter.AllowN(c.clock.Now(), 1) {
+					launch()
+					inflight++
+				}
+				timer.Reset(c.hedge.delay)
+			}
+			continue
+		case r := <-results:
+			inflight--
+			if r.err == nil {
+				for i, cancel := range cancels {
+					if i != r.index {
+						abandoned[i].Store(true)
+						cancel()
+					}
+				}
+				go discardHedges(results, inflight)
+				r.resp.Body = &cancelBody{ReadCloser: r.resp.Body, cancel: cancels[r.index]}
+				return r.resp, nil
+			}
+			cancels[r.index]()
+			if r.index == 0 {
+				errs = append([]error{r.err}, errs...)
+			} else {
+				errs = append(errs, r.err)
+			}
+			if inflight == 0 {
+				// Report the original attempt's error in preference to
+				// those of its duplicates.
+				return nil, errs[0]
+			}
+		}
+	}
+}
+
+// hedgeAbandonedKey marks the context of a copy of a hedged attempt. Its
+// value, an *atomic.Bool, is set before the copy is cancelled because another
+// one won.
+type hedgeAbandonedKey struct{}
+
+// hedgeAbandoned reports whether ctx belongs to a copy of a hedged attempt
+// that was cancelled because another copy won, so that its failure says
+// nothing about the request or the API.
+func hedgeAbandoned(ctx context.Context) bool {
+	lost, ok := ctx.Value(hedgeAbandonedKey{}).(*atomic.Bool)
+	return ok && lost.Load()
+}
+
+// discardHedges closes the responses of the n copies of a hedged attempt
+// still running after another copy won.
+func discardHedges(results <-chan hedgeResult, n int) {
+	for ; n > 0; n-- {
+		if r := <-results; r.err == nil {
+			r.resp.Body.Close()
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHedgeLosersNotReported(t *testing.T) {
+	var hits atomic.Int32
+	var (
+		mu       sync.Mutex
+		hookErrs []error
+	)
+	metrics := &MemoryMetrics{}
+	var debug syncBuffer
+	loserDone := make(chan struct{})
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			// Stall the first copy until the client gives up on it.
+			<-r.Context().Done()
+			return
+		}
+		writeJSON(w, fullPage)
+	},
+		WithHedging(20*time.Millisecond, 1),
+		WithMetrics(metrics),
+		WithDebug(&debug),
+		WithResponseHook(func(resp *http.Response, d time.Duration, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			hookErrs = append(hookErrs, err)
+		}),
+		WithMiddleware(func(next RoundTripperFunc) RoundTripperFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				resp, err := next(req)
+				if err != nil {
+					close(loserDone)
+				}
+				return resp, err
+			}
+		}),
+	)
+
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	<-loserDone
+	// Give the losing copy's round trip time to return.
+	time.Sleep(20 * time.Millisecond)
+
+	if stats := metrics.Stats("/telemetry/"); stats.Requests != 1 || stats.Errors != 0 {
+		t.Errorf("metrics = %+v, want only the winning copy", stats)
+	}
+	mu.Lock()
+	if len(hookErrs) != 1 || hookErrs[0] != nil {
+		t.Errorf("response hook saw %v, want only the winner", hookErrs)
+	}
+	mu.Unlock()
+	if dump := debug.String(); strings.Contains(dump, "request failed") {
+		t.Errorf("debug log reports the cancelled copy:\n%s", dump)
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
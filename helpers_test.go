@@ -0,0 +1,116 @@
+package gosatnogs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestClient starts an httptest server running h and returns a client
+// pointed at it. The server is closed when the test ends.
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...Option) (*Client, *httptest.Server) {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	c, err := New("test-key", append([]Option{WithBaseURL(srv.URL)}, opts...)...)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c, srv
+}
+
+// writeJSON answers with a 200 and body as the JSON payload.
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(body))
+}
+
+// fakeClock is a clock whose Sleep returns at once, advancing Now by the
+// requested duration and recording it.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+	// onSleep, if set, is called at the start of every Sleep.
+	onSleep func()
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if f.onSleep != nil {
+		f.onSleep()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sleeps = append(f.sleeps, d)
+	f.now = f.now.Add(d)
+	return nil
+}
+
+// Advance moves the clock forward by d without recording a sleep.
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Sleeps returns the durations passed to Sleep so far.
+func (f *fakeClock) Sleeps() []time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Duration(nil), f.sleeps...)
+}
+
+// pagedTelemetry serves records telemetry records for any satellite, page by
+// page. Pages hold page_size records, 2 by default, and are selected with a
+// page parameter starting at 1. Next and Prev links keep the rest of the
+// query and point at the host the request was sent to.
+func pagedTelemetry(records int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		size, _ := strconv.Atoi(q.Get("page_size"))
+		if size <= 0 {
+			size = 2
+		}
+		page, _ := strconv.Atoi(q.Get("page"))
+		if page <= 0 {
+			page = 1
+		}
+		link := func(p int) *string {
+			if p < 1 || (p-1)*size >= records {
+				return nil
+			}
+			q.Set("page", strconv.Itoa(p))
+			u := "http://" + r.Host + r.URL.Path + "?" + q.Encode()
+			return &u
+		}
+		var results []Telemetry
+		for i := (page - 1) * size; i < min(page*size, records); i++ {
+			results = append(results, Telemetry{SatID: q.Get("sat_id"), Observer: q.Get("observer"), ObservationID: i + 1})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"count":   records,
+			"next":    link(page + 1),
+			"prev":    link(page - 1),
+			"results": results,
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package gosatnogs
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestHook is called with every outgoing request just before it is sent.
+// Hooks may run concurrently when the client is shared between goroutines.
+type RequestHook func(req *http.Request)
+
+// ResponseHook is called after every round trip with the response (nil if the
+// transport failed), the time the round trip took, and the transport error,
+// if any. The hook must not read or close the response body, and may run
+// concurrently.
+type ResponseHook func(resp *http.Response, d time.Duration, err error)
+
+// WithRequestHook registers h to observe every request the client sends,
+// including page fetches and retries. It may be given more than once; hooks
+// run in registration order. A panicking hook is recovered and ignored.
+func WithRequestHook(h RequestHook) Option {
+	return func(c *Client) error {
+		c.requestHooks = append(c.requestHooks, h)
+		return nil
+	}
+}
+
+// WithResponseHook registers h to observe the outcome of every round trip,
+// including ones where the transport returned an error. It may be given more
+// than once; hooks run in registration order. A panicking hook is recovered
+// and ignored.
+func WithResponseHook(h ResponseHook) Option {
+	return func(c *Client) error {
+		c.responseHooks = append(c.responseHooks, h)
+		return nil
+	}
+}
+
+// roundTrip sends one attempt of req, running the registered hooks around it
+// and reporting it to the metrics recorder. Copies of hedged attempts that
+// fail because another copy won are not reported.
+func (c *Client) roundTrip(req *http.Request, attempt int) (*http.Response, error) {
+	if err := c.usage.acquire(c.endpoint(req.URL), attempt); err != nil {
+		return nil, err
+	}
+	for _, h := range c.requestHooks {
+		func() {
+			defer func() { _ = recover() }()
+			h(req)
+		}()
+	}
+	if c.debug != nil {
+		c.debug.dumpRequest(req)
+	}
+	hc := *c.client
+	hc.CheckRedirect = c.redirectPolicy(hc.CheckRedirect)
+	if ro := requestOptionsFrom(req.Context()); ro.timeout > 0 || ro.noClientTimeout {
+		// The call's context deadline replaces the client-wide timeout.
+		hc.Timeout = 0
+	}
+	start := time.Now()
+	resp, err := c.chain(hc.Do)(req)
+	if err == nil {
+		resp.Body = &countingBody{ReadCloser: resp.Body, n: &c.usage.bytes}
+		if err = decompress(resp); err != nil {
+			resp = nil
+		}
+	}
+	if err != nil && hedgeAbandoned(req.Context()) {
+		// A losing hedge copy, cancelled on purpose: not worth reporting.
+		return nil, err
+	}
+	d := time.Since(start)
+	if c.debug != nil {
+		c.debug.dumpResponse(resp, err)
+	}
+	if span, ok := spanFrom(req.Context()); ok && resp != nil {
+		span.SetAttribute(AttrHTTPStatus, resp.StatusCode)
+	}
+	if c.metrics != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		c.metrics.ObserveRequest(c.endpoint(req.URL), req.Method, status, d, attempt, err)
+	}
+	for _, h := range c.responseHooks {
+		func() {
+			defer func() { _ = recover() }()
+			h(resp, d, err)
+		}()
+	}
+	return resp, err
+}
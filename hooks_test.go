@@ -0,0 +1,82 @@
+package gosatnogs
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestHooksInOrder(t *testing.T) {
+	var calls []string
+	hook := func(name string) RequestHook {
+		return func(req *http.Request) {
+			calls = append(calls, name+" "+req.Method+" "+req.URL.Path)
+		}
+	}
+	c, _ := newTestClient(t, pagedTelemetry(3), WithRequestHook(hook("first")), WithRequestHook(hook("second")))
+
+	page, err := c.GetTelemetryResponse("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTelemetryResponseNextPage(page); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Repeat("first GET /telemetry/ second GET /telemetry/ ", 2)
+	if got := strings.Join(calls, " ") + " "; got != want {
+		t.Errorf("hooks ran as %q, want %q", got, want)
+	}
+}
+
+func TestResponseHooksInOrder(t *testing.T) {
+	var calls []string
+	hook := func(name string) ResponseHook {
+		return func(resp *http.Response, d time.Duration, err error) {
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			calls = append(calls, fmt.Sprintf("%s %d %v", name, status, err != nil))
+		}
+	}
+	hooks := []Option{WithResponseHook(hook("first")), WithResponseHook(hook("second"))}
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusNotFound)
+	}, hooks...)
+	c.GetTelemetry("AAAA-0000")
+
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+	c, err := New("", append(hooks, WithBaseURL(dead.URL))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.GetTelemetry("AAAA-0000")
+
+	if got, want := fmt.Sprint(calls), "[first 404 false second 404 false first 0 true second 0 true]"; got != want {
+		t.Errorf("hooks ran as %s, want %s", got, want)
+	}
+}
+
+func TestPanickingHooksRecovered(t *testing.T) {
+	var ran []string
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, fullPage)
+	},
+		WithRequestHook(func(*http.Request) { panic("request hook") }),
+		WithRequestHook(func(*http.Request) { ran = append(ran, "request") }),
+		WithResponseHook(func(*http.Response, time.Duration, error) { panic("response hook") }),
+		WithResponseHook(func(*http.Response, time.Duration, error) { ran = append(ran, "response") }),
+	)
+
+	got, err := c.GetTelemetry("AAAA-0000")
+	if err != nil || len(got) != 1 {
+		t.Fatalf("GetTelemetry = %v, %v; want the page despite the panics", got, err)
+	}
+	if fmt.Sprint(ran) != "[request response]" {
+		t.Errorf("hooks after the panicking ones ran as %v", ran)
+	}
+}
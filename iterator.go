@@ -0,0 +1,84 @@
+package gosatnogs
+
+import "context"
+
+// TelemetryIterator walks through a satellite's telemetry one record at a
+// time, fetching pages lazily as it advances. Use it like bufio.Scanner:
+//
+//	it := client.IterateTelemetry(ctx, "XXXX-XXXX-XXXX-XXXX-XXXX")
+//	for it.Next() {
+//		t := it.Current()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type TelemetryIterator struct {
+	ctx         context.Context
+	client      *Client
+	satelliteID string
+
+	page    *TelemetryResponse
+	index   int
+	current Telemetry
+	err     error
+	done    bool
+}
+
+// IterateTelemetry returns an iterator over all telemetry for a satellite. No
+// request is made until the first call to Next.
+func (c *Client) IterateTelemetry(ctx context.Context, satelliteID string) *TelemetryIterator {
+	return &TelemetryIterator{ctx: ctx, client: c, satelliteID: satelliteID}
+}
+
+// Next advances to the next record, fetching the next page when the current
+// one is exhausted. It returns false at the end of the telemetry or when an
+// error occurs; call Err to tell the two apart.
+func (it *TelemetryIterator) Next() bool {
+	if it.done {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Results) {
+		if !it.fetch() {
+			it.done = true
+			return false
+		}
+	}
+	it.current = it.page.Results[it.index]
+	it.index++
+	return true
+}
+
+// fetch loads the first or next page. It reports whether a page was loaded.
+func (it *TelemetryIterator) fetch() bool {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	var page *TelemetryResponse
+	var err error
+	if it.page == nil {
+		page, err = it.client.GetTelemetryResponseContext(it.ctx, it.satelliteID)
+	} else {
+		page, err = it.client.GetTelemetryResponseNextPageContext(it.ctx, it.page)
+	}
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if page == nil {
+		return false
+	}
+	it.page, it.index = page, 0
+	return true
+}
+
+// Current returns the record Next most recently advanced to.
+func (it *TelemetryIterator) Current() Telemetry {
+	return it.current
+}
+
+// Err returns the first error encountered during iteration, if any.
+func (it *TelemetryIterator) Err() error {
+	return it.err
+}
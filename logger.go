@@ -0,0 +1,37 @@
+package gosatnogs
+
+import (
+	"net/http"
+	"time"
+)
+
+// Logger receives one call before and one after every round trip the client
+// makes. url never contains the API key, which travels only in the
+// Authorization header. status is zero when the transport failed.
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	LogRequest(method, url string)
+	LogResponse(status int, d time.Duration)
+}
+
+// WithLogger reports every round trip, retries included, to l. Without this
+// option nothing is logged. It is built on WithRequestHook and
+// WithResponseHook and may be combined with them.
+func WithLogger(l Logger) Option {
+	return func(c *Client) error {
+		if l == nil {
+			return nil
+		}
+		c.requestHooks = append(c.requestHooks, func(req *http.Request) {
+			l.LogRequest(req.Method, req.URL.Redacted())
+		})
+		c.responseHooks = append(c.responseHooks, func(resp *http.Response, d time.Duration, err error) {
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			l.LogResponse(status, d)
+		})
+		return nil
+	}
+}
@@ -0,0 +1,83 @@
+package gosatnogs
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordingLogger keeps a line per call, "GET /path" for requests and the
+// status for responses.
+type recordingLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) LogRequest(method, rawURL string) {
+	u, _ := url.Parse(rawURL)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, method+" "+u.Path)
+}
+
+func (l *recordingLogger) LogResponse(status int, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprint(status))
+}
+
+func (l *recordingLogger) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return fmt.Sprint(l.lines)
+}
+
+func TestWithLoggerRequestAndRetry(t *testing.T) {
+	var hits atomic.Int32
+	l := &recordingLogger{}
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, fullPage)
+	}, WithLogger(l), WithRetries(1), withClock(newFakeClock()))
+
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := l.String(), "[GET /telemetry/ 503 GET /telemetry/ 200]"; got != want {
+		t.Errorf("logged %s, want %s", got, want)
+	}
+}
+
+func TestWithLoggerTransportError(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+	l := &recordingLogger{}
+	c, err := New("test-key", WithBaseURL(dead.URL), WithLogger(l))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetTelemetry("AAAA-0000"); err == nil {
+		t.Fatal("GetTelemetry succeeded against a closed server")
+	}
+	if got, want := l.String(), "[GET /telemetry/ 0]"; got != want {
+		t.Errorf("logged %s, want %s", got, want)
+	}
+}
+
+func TestWithLoggerNil(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, fullPage)
+	}, WithLogger(nil))
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,89 @@
+package gosatnogs
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// LookupCache wraps a Client and keeps the results of GetModes and GetTLE in
+// memory for a fixed TTL, refreshing an entry lazily on the first lookup
+// after it expires. Entries age by the client's clock. Errors are not cached.
+// It is safe for concurrent use.
+type LookupCache struct {
+	client *Client
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	modes *lookupEntry[[]Mode]
+	tles  map[int]*lookupEntry[*TLE]
+}
+
+type lookupEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+// NewLookupCache returns a LookupCache that serves cached values for ttl.
+func NewLookupCache(c *Client, ttl time.Duration) *LookupCache {
+	return &LookupCache{
+		client: c,
+		ttl:    ttl,
+		tles:   make(map[int]*lookupEntry[*TLE]),
+	}
+}
+
+// GetModes is like Client.GetModesContext but served from the cache while
+// the cached list is fresh.
+func (lc *LookupCache) GetModes(ctx context.Context) ([]Mode, error) {
+	lc.mu.Lock()
+	if e := lc.modes; e != nil && lc.client.clock.Now().Before(e.expires) {
+		lc.mu.Unlock()
+		return e.value, nil
+	}
+	lc.mu.Unlock()
+
+	modes, err := lc.client.GetModesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lc.mu.Lock()
+	lc.modes = &lookupEntry[[]Mode]{value: modes, expires: lc.client.clock.Now().Add(lc.ttl)}
+	lc.mu.Unlock()
+	return modes, nil
+}
+
+// GetTLE is like Client.GetTLEContext but served from the cache while the
+// cached elements for noradID are fresh.
+func (lc *LookupCache) GetTLE(ctx context.Context, noradID int) (*TLE, error) {
+	lc.mu.Lock()
+	if e, ok := lc.tles[noradID]; ok && lc.client.clock.Now().Before(e.expires) {
+		lc.mu.Unlock()
+		return e.value, nil
+	}
+	lc.mu.Unlock()
+
+	tle, err := lc.client.GetTLEContext(ctx, noradID)
+	if err != nil {
+		return nil, err
+	}
+	lc.mu.Lock()
+	lc.tles[noradID] = &lookupEntry[*TLE]{value: tle, expires: lc.client.clock.Now().Add(lc.ttl)}
+	lc.mu.Unlock()
+	return tle, nil
+}
+
+// InvalidateModes drops the cached mode list so the next GetModes fetches it.
+func (lc *LookupCache) InvalidateModes() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.modes = nil
+}
+
+// InvalidateTLE drops the cached elements for noradID so the next GetTLE for
+// it fetches them.
+func (lc *LookupCache) InvalidateTLE(noradID int) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	delete(lc.tles, noradID)
+}
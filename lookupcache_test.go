@@ -0,0 +1,84 @@
+package gosatnogs
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLookupCacheTTLAndInvalidate(t *testing.T) {
+	var modeHits, tleHits atomic.Int32
+	clk := newFakeClock()
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/modes/":
+			modeHits.Add(1)
+			writeJSON(w, `[{"id":1,"name":"FM"}]`)
+		case "/tle/":
+			tleHits.Add(1)
+			writeJSON(w, `[{"tle0":"ISS","norad_cat_id":25544}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}, withClock(clk))
+	lc := NewLookupCache(c, time.Hour)
+	ctx := context.Background()
+
+	lookup := func() {
+		t.Helper()
+		if _, err := lc.GetModes(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := lc.GetTLE(ctx, 25544); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expect := func(modes, tles int32) {
+		t.Helper()
+		if m, tl := modeHits.Load(), tleHits.Load(); m != modes || tl != tles {
+			t.Errorf("fetched modes %d and TLEs %d times, want %d and %d", m, tl, modes, tles)
+		}
+	}
+
+	lookup()
+	lookup()
+	expect(1, 1)
+
+	clk.Advance(time.Hour - time.Second)
+	lookup()
+	expect(1, 1)
+
+	clk.Advance(time.Second)
+	lookup()
+	expect(2, 2)
+
+	lc.InvalidateModes()
+	lookup()
+	expect(3, 2)
+
+	lc.InvalidateTLE(25544)
+	lookup()
+	expect(3, 3)
+}
+
+func TestLookupCacheSkipsErrors(t *testing.T) {
+	var hits atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			http.Error(w, "nope", http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, `[{"id":1,"name":"FM"}]`)
+	})
+	lc := NewLookupCache(c, time.Hour)
+
+	if _, err := lc.GetModes(context.Background()); err == nil {
+		t.Fatal("GetModes succeeded on a 400")
+	}
+	modes, err := lc.GetModes(context.Background())
+	if err != nil || len(modes) != 1 {
+		t.Errorf("GetModes after an error = %v, %v; want a fresh fetch", modes, err)
+	}
+}
@@ -0,0 +1,84 @@
+package gosatnogs
+
+import (
+	"sync"
+	"time"
+)
+
+// MetricsRecorder receives one observation per HTTP round trip the client
+// makes. endpoint is the request path relative to the base URL, status is
+// zero when the transport failed, and attempt is 1 for the first try of a
+// request and increases with every retry. Implementations must be safe for
+// concurrent use.
+type MetricsRecorder interface {
+	ObserveRequest(endpoint, method string, status int, d time.Duration, attempt int, err error)
+}
+
+// WithMetrics reports every round trip, including retries, to m. Without this
+// option no metrics are recorded.
+func WithMetrics(m MetricsRecorder) Option {
+	return func(c *Client) error {
+		c.metrics = m
+		return nil
+	}
+}
+
+// MetricsFunc adapts a function that only needs the endpoint, status and
+// duration of each round trip to a MetricsRecorder, e.g. to feed a
+// Prometheus counter and histogram:
+//
+//	gosatnogs.WithMetrics(gosatnogs.MetricsFunc(func(endpoint string, status int, d time.Duration) {
+//		requests.WithLabelValues(endpoint, strconv.Itoa(status)).Inc()
+//		latency.WithLabelValues(endpoint).Observe(d.Seconds())
+//	}))
+type MetricsFunc func(endpoint string, status int, d time.Duration)
+
+// ObserveRequest calls f(endpoint, status, d).
+func (f MetricsFunc) ObserveRequest(endpoint, method string, status int, d time.Duration, attempt int, err error) {
+	f(endpoint, status, d)
+}
+
+// EndpointStats are the counters MemoryMetrics keeps for one endpoint.
+type EndpointStats struct {
+	// Requests counts every round trip, retries included.
+	Requests int
+	// Retries counts round trips with an attempt number above 1.
+	Retries int
+	// Errors counts round trips that failed in the transport or returned a
+	// status outside the 2xx range.
+	Errors int
+	// Duration is the total time spent in round trips.
+	Duration time.Duration
+}
+
+// MemoryMetrics is a MetricsRecorder that keeps per-endpoint counters in
+// memory. It is safe for concurrent use.
+type MemoryMetrics struct {
+	mu        sync.Mutex
+	endpoints map[string]EndpointStats
+}
+
+func (m *MemoryMetrics) ObserveRequest(endpoint, method string, status int, d time.Duration, attempt int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.endpoints == nil {
+		m.endpoints = make(map[string]EndpointStats)
+	}
+	s := m.endpoints[endpoint]
+	s.Requests++
+	if attempt > 1 {
+		s.Retries++
+	}
+	if err != nil || status < 200 || status > 299 {
+		s.Errors++
+	}
+	s.Duration += d
+	m.endpoints[endpoint] = s
+}
+
+// Stats returns the counters recorded for endpoint.
+func (m *MemoryMetrics) Stats(endpoint string) EndpointStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.endpoints[endpoint]
+}
@@ -0,0 +1,68 @@
+package gosatnogs
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// RoundTripperFunc is a function that sends a request and returns its
+// response. It implements http.RoundTripper.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the step that sends a request, letting it inspect or
+// modify the request, the response, or both. The returned function is
+// called concurrently when the client is shared between goroutines.
+type Middleware func(next RoundTripperFunc) RoundTripperFunc
+
+// WithMiddleware adds mw to the chain every request passes through, including
+// page fetches and each retry attempt. It may be given more than once.
+// Middleware runs in the order it was added: the first one added sees the
+// request first and the response last.
+func WithMiddleware(mw Middleware) Option {
+	return func(c *Client) error {
+		c.middleware = append(c.middleware, mw)
+		return nil
+	}
+}
+
+// chain wraps send in the client's middleware.
+func (c *Client) chain(send RoundTripperFunc) RoundTripperFunc {
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		send = c.middleware[i](send)
+	}
+	return send
+}
+
+// HeaderMiddleware returns a Middleware that sets the header key to value on
+// every request.
+func HeaderMiddleware(key, value string) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(key, value)
+			return next(req)
+		}
+	}
+}
+
+// LoggingMiddleware returns a Middleware that logs the method, URL, status
+// and duration of every request to logger. Headers, and therefore the API
+// key, are never logged.
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			if err != nil {
+				logger.Printf("%s %s: %v (%s)", req.Method, req.URL, err, time.Since(start))
+			} else {
+				logger.Printf("%s %s: %s (%s)", req.Method, req.URL, resp.Status, time.Since(start))
+			}
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		trace []string
+	)
+	record := func(name string) Middleware {
+		return func(next RoundTripperFunc) RoundTripperFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				trace = append(trace, name+" in")
+				mu.Unlock()
+				resp, err := next(req)
+				mu.Lock()
+				trace = append(trace, name+" out")
+				mu.Unlock()
+				return resp, err
+			}
+		}
+	}
+	var headers []string
+	serve := pagedTelemetry(4)
+	var logs bytes.Buffer
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("X-Layer"))
+		serve(w, r)
+	},
+		WithMiddleware(record("first")),
+		WithMiddleware(HeaderMiddleware("X-Layer", "outer")),
+		WithMiddleware(record("second")),
+		WithMiddleware(HeaderMiddleware("X-Layer", "inner")),
+		WithMiddleware(LoggingMiddleware(log.New(&logs, "", 0))),
+	)
+
+	if _, err := c.GetAllTelemetry(context.Background(), "AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	once := []string{"first in", "second in", "second out", "first out"}
+	if want := append(slices.Clone(once), once...); !slices.Equal(trace, want) {
+		t.Errorf("middleware ran as %v, want %v", trace, want)
+	}
+	if !slices.Equal(headers, []string{"inner", "inner"}) {
+		t.Errorf("X-Layer = %v, want the innermost value on both pages", headers)
+	}
+	if n := strings.Count(logs.String(), "200 OK"); n != 2 {
+		t.Errorf("logged %d responses, want 2:\n%s", n, logs.String())
+	}
+	if strings.Contains(logs.String(), "test-key") {
+		t.Errorf("LoggingMiddleware logged the API key:\n%s", logs.String())
+	}
+}
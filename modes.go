@@ -0,0 +1,26 @@
+package gosatnogs
+
+import (
+	"context"
+)
+
+// Mode is a modulation mode referenced by transmitters, e.g. "FSK9k6".
+type Mode struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetModes retrieves every mode known to the SatNOGS DB. The endpoint is not
+// paginated.
+func (c *Client) GetModes() ([]Mode, error) {
+	return c.GetModesContext(context.Background())
+}
+
+// GetModesContext is like GetModes but binds the request to ctx.
+func (c *Client) GetModesContext(ctx context.Context) ([]Mode, error) {
+	var modes []Mode
+	if err := c.getJSON(ctx, "/modes/", Params{"format": {"json"}}, &modes); err != nil {
+		return nil, err
+	}
+	return modes, nil
+}
@@ -0,0 +1,86 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// defaultConcurrency is how many requests GetTelemetryForSatellites runs at
+// once unless WithConcurrency says otherwise.
+const defaultConcurrency = 4
+
+// WithConcurrency sets how many requests helpers that fan out over several
+// satellites, such as GetTelemetryForSatellites, run at once. The default is
+// 4. Raise WithMaxIdleConnsPerHost to match when going much higher.
+func WithConcurrency(n int) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return fmt.Errorf("satnogs: invalid concurrency %d", n)
+		}
+		c.concurrency = n
+		return nil
+	}
+}
+
+// SatelliteError is the failure of one satellite in a call that covers
+// several. It unwraps to the underlying error.
+type SatelliteError struct {
+	SatelliteID string
+	Err         error
+}
+
+func (e *SatelliteError) Error() string {
+	return fmt.Sprintf("satnogs: satellite %s: %v", e.SatelliteID, e.Err)
+}
+
+func (e *SatelliteError) Unwrap() error {
+	return e.Err
+}
+
+// GetTelemetryForSatellites retrieves the first page of telemetry, the newest
+// records, for each of satIDs concurrently, running at most as many requests
+// at once as set with WithConcurrency. The map holds the results of every
+// satellite that succeeded. If any failed, the error joins one *SatelliteError
+// per failure (see errors.Join), so errors.As and errors.Is reach each of
+// them, and the successful results are still returned.
+func (c *Client) GetTelemetryForSatellites(ctx context.Context, satIDs []string) (map[string][]Telemetry, error) {
+	n := c.concurrency
+	if n <= 0 {
+		n = defaultConcurrency
+	}
+
+	var (
+		mu      sync.Mutex
+		results = make(map[string][]Telemetry, len(satIDs))
+		errs    []error
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, n)
+	)
+	for _, id := range satIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs = append(errs, &SatelliteError{SatelliteID: id, Err: ctx.Err()})
+				mu.Unlock()
+				return
+			}
+			records, err := c.GetTelemetryContext(ctx, id)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, &SatelliteError{SatelliteID: id, Err: err})
+				return
+			}
+			results[id] = records
+		}()
+	}
+	wg.Wait()
+	return results, errors.Join(errs...)
+}
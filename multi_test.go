@@ -0,0 +1,89 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetTelemetryForSatellitesConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		writeJSON(w, `{"count":1,"results":[{"sat_id":"`+r.URL.Query().Get("sat_id")+`"}]}`)
+	}, WithConcurrency(2))
+
+	ids := []string{"A", "B", "C", "D", "E", "F", "G", "H"}
+	results, err := c.GetTelemetryForSatellites(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != len(ids) {
+		t.Errorf("got results for %d satellites, want %d", len(results), len(ids))
+	}
+	for _, id := range ids {
+		if r := results[id]; len(r) != 1 || r[0].SatID != id {
+			t.Errorf("results[%s] = %+v", id, r)
+		}
+	}
+	if p := peak.Load(); p != 2 {
+		t.Errorf("peak concurrency = %d, want 2", p)
+	}
+
+	if _, err := New("", WithConcurrency(0)); err == nil {
+		t.Error("WithConcurrency(0) accepted")
+	}
+}
+
+func TestGetTelemetryForSatellitesPartialErrors(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Query().Get("sat_id")
+		if strings.HasPrefix(id, "BAD") {
+			http.Error(w, `{"detail":"Not found."}`, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, `{"count":1,"results":[{"sat_id":"`+id+`"}]}`)
+	})
+
+	results, err := c.GetTelemetryForSatellites(context.Background(), []string{"GOOD-1", "BAD-1", "GOOD-2", "BAD-2"})
+	if err == nil {
+		t.Fatal("GetTelemetryForSatellites reported no error")
+	}
+	if len(results) != 2 || results["GOOD-1"] == nil || results["GOOD-2"] == nil {
+		t.Errorf("results = %v, want the two good satellites", results)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want it to match ErrNotFound", err)
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("err %T does not join the failures", err)
+	}
+	var failed []string
+	for _, e := range joined.Unwrap() {
+		var satErr *SatelliteError
+		if !errors.As(e, &satErr) {
+			t.Errorf("%v is not a *SatelliteError", e)
+			continue
+		}
+		failed = append(failed, satErr.SatelliteID)
+	}
+	slices.Sort(failed)
+	if !slices.Equal(failed, []string{"BAD-1", "BAD-2"}) {
+		t.Errorf("failed satellites = %v, want BAD-1 and BAD-2", failed)
+	}
+}
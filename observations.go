@@ -0,0 +1,135 @@
+package gosatnogs
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// Observation is a scheduled or completed pass recorded by a SatNOGS network
+// ground station. Observations are served by the Network API, so they must be
+// requested with a client created with WithBaseURL(NetworkBaseURL).
+type Observation struct {
+	ID            int       `json:"id"`
+	Start         time.Time `json:"start"`
+	End           time.Time `json:"end"`
+	Status        string    `json:"status"`
+	GroundStation int       `json:"ground_station"`
+	StationName   string    `json:"station_name"`
+	NoradCatID    int       `json:"norad_cat_id"`
+	// Transmitter is the UUID of the observed transmitter, as in
+	// Transmitter.UUID.
+	Transmitter string `json:"transmitter"`
+	// VettedStatus is the outcome of vetting, e.g. "good", "bad" or
+	// "unknown", and VettedAt when it happened, nil if it has not.
+	VettedStatus string     `json:"vetted_status"`
+	VettedAt     *time.Time `json:"vetted_datetime"`
+	// Waterfall is the URL of the waterfall image.
+	Waterfall string `json:"waterfall"`
+	Payload   string `json:"payload"`
+	Observer  string `json:"observer"`
+}
+
+// ObservationResponse is a page of observations.
+type ObservationResponse = Page[Observation]
+
+// ObservationFilter narrows the observations returned by GetObservations.
+// Zero values are not sent to the API.
+type ObservationFilter struct {
+	NoradCatID int
+	// GroundStation is the ID of the station that made the observations.
+	GroundStation int
+	// Status is the observation status, e.g. "good", "bad" or "failed".
+	Status string
+	// Start and End bound the observation window.
+	Start time.Time
+	End   time.Time
+}
+
+func (f ObservationFilter) params() Params {
+	params := Params{"format": {"json"}}
+	if f.NoradCatID != 0 {
+		params.SetInt("satellite__norad_cat_id", f.NoradCatID)
+	}
+	if f.GroundStation != 0 {
+		params.SetInt("ground_station", f.GroundStation)
+	}
+	if f.Status != "" {
+		params.Set("status", f.Status)
+	}
+	if !f.Start.IsZero() {
+		params.SetTime("start", f.Start)
+	}
+	if !f.End.IsZero() {
+		params.SetTime("end", f.End)
+	}
+	return params
+}
+
+// GetObservations retrieves the first page of observations matching filter
+// from the Network API. Use GetObservationResponse to follow pagination.
+func (c *Client) GetObservations(filter ObservationFilter) ([]Observation, error) {
+	return c.GetObservationsContext(context.Background(), filter)
+}
+
+// GetObservationsContext is like GetObservations but binds the request to ctx.
+func (c *Client) GetObservationsContext(ctx context.Context, filter ObservationFilter) ([]Observation, error) {
+	resp, err := c.GetObservationResponseContext(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *Client) GetObservationResponse(filter ObservationFilter) (*ObservationResponse, error) {
+	return c.GetObservationResponseContext(context.Background(), filter)
+}
+
+// GetObservationResponseContext is like GetObservationResponse but binds the request to ctx.
+func (c *Client) GetObservationResponseContext(ctx context.Context, filter ObservationFilter) (*ObservationResponse, error) {
+	var observationResponse ObservationResponse
+	if err := c.getJSON(ctx, "/observations/", filter.params(), &observationResponse); err != nil {
+		return nil, err
+	}
+	return &observationResponse, nil
+}
+
+// GetObservation retrieves the observation with the given ID from the Network
+// API. An unknown ID yields an error matching ErrNotFound.
+func (c *Client) GetObservation(ctx context.Context, id int) (*Observation, error) {
+	var observation Observation
+	if err := c.getJSON(ctx, "/observations/"+strconv.Itoa(id)+"/", Params{"format": {"json"}}, &observation); err != nil {
+		return nil, err
+	}
+	return &observation, nil
+}
+
+// Observation retrieves the network observation that captured t, using
+// network, a client for the Network API (see NetworkBaseURL). It returns
+// ErrNoObservation if t is not linked to one.
+func (t Telemetry) Observation(ctx context.Context, network *Client) (*Observation, error) {
+	if t.ObservationID == 0 {
+		return nil, ErrNoObservation
+	}
+	return network.GetObservation(ctx, t.ObservationID)
+}
+
+func (c *Client) GetObservationResponseNextPage(o *ObservationResponse) (*ObservationResponse, error) {
+	return c.GetObservationResponseNextPageContext(context.Background(), o)
+}
+
+// GetObservationResponseNextPageContext is like GetObservationResponseNextPage but
+// binds the request to ctx.
+func (c *Client) GetObservationResponseNextPageContext(ctx context.Context, o *ObservationResponse) (*ObservationResponse, error) {
+	return NextPage(ctx, c, o)
+}
+
+func (c *Client) GetObservationResponsePrevPage(o *ObservationResponse) (*ObservationResponse, error) {
+	return c.GetObservationResponsePrevPageContext(context.Background(), o)
+}
+
+// GetObservationResponsePrevPageContext is like GetObservationResponsePrevPage but
+// binds the request to ctx.
+func (c *Client) GetObservationResponsePrevPageContext(ctx context.Context, o *ObservationResponse) (*ObservationResponse, error) {
+	return PrevPage(ctx, c, o)
+}
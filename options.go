@@ -0,0 +1,192 @@
+package gosatnogs
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Option configures a Client. Options are applied by New and NewClient in the
+// order they are given, so a later option overrides an earlier one that
+// touches the same setting. An option returns an error when its arguments are
+// invalid.
+type Option func(*Client) error
+
+// WithHTTPClient makes the Client send requests through hc instead of its
+// default http.Client. The supplied client is used as-is, including its
+// timeout and transport.
+//
+// A WithTimeout that appears after WithHTTPClient applies the timeout to a
+// copy of hc, leaving the caller's client untouched; a WithTimeout that appears
+// before it is discarded along with the default client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) error {
+		if hc != nil {
+			c.client = hc
+		}
+		return nil
+	}
+}
+
+// WithTimeout sets the overall timeout of each HTTP request. The default is 10
+// seconds. A zero value means no timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		hc := *c.client
+		hc.Timeout = d
+		c.client = &hc
+		return nil
+	}
+}
+
+// WithRequestTimeout gives every call made with the client a context deadline
+// of d, even when the caller passes context.Background(). Unlike WithTimeout,
+// which is enforced by the http.Client, the deadline travels in the request
+// context, covers the whole call including retries and reading the body, and
+// leaves connection reuse untouched. Combine it with WithTimeout(0) to bound
+// calls by the deadline alone. A WithCallTimeout on the context takes
+// precedence, and an earlier deadline already on the context still wins. A
+// zero value, the default, adds no deadline.
+func WithRequestTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d < 0 {
+			return fmt.Errorf("satnogs: request timeout must not be negative, got %v", d)
+		}
+		c.requestTimeout = d
+		return nil
+	}
+}
+
+// WithBaseURL points the Client at a different API root, such as a mock server
+// or a self-hosted SatNOGS DB instance. The default is https://db.satnogs.org/api.
+//
+// The URL must be absolute with an http or https scheme. Trailing slashes are
+// trimmed, so "https://db.example.org/api/" and "https://db.example.org/api"
+// are equivalent.
+func WithBaseURL(rawURL string) Option {
+	return func(c *Client) error {
+		base, err := parseBaseURL(rawURL)
+		if err != nil {
+			return err
+		}
+		c.baseURL = base
+		return nil
+	}
+}
+
+// parseBaseURL validates an API root URL and returns it without trailing
+// slashes.
+func parseBaseURL(rawURL string) (string, error) {
+	u, err := url.Parse(strings.TrimRight(rawURL, "/"))
+	if err != nil {
+		return "", fmt.Errorf("satnogs: invalid base URL %q: %w", rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("satnogs: invalid base URL %q: scheme must be http or https", rawURL)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("satnogs: invalid base URL %q: missing host", rawURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("satnogs: invalid base URL %q: must not contain a query or fragment", rawURL)
+	}
+	return u.String(), nil
+}
+
+// WithHeader sets the header key to value on every request the client sends,
+// including Next and Prev page fetches, e.g. a token required by a gateway in
+// front of a mirror. It may be given more than once. The Authorization header
+// carries the API key and cannot be set this way; use WithAPIKey.
+func WithHeader(key, value string) Option {
+	return func(c *Client) error {
+		if http.CanonicalHeaderKey(key) == "Authorization" {
+			return fmt.Errorf("satnogs: the Authorization header cannot be set with WithHeader; use WithAPIKey")
+		}
+		if c.header == nil {
+			c.header = make(http.Header)
+		}
+		c.header.Set(key, value)
+		return nil
+	}
+}
+
+// MaxPageSize is the largest page size the SatNOGS DB API accepts.
+const MaxPageSize = 100
+
+// WithPageSize requests n telemetry records per page instead of the server's
+// default. Larger pages mean fewer round trips for GetAllTelemetry and the
+// pagination helpers, which keep the size because the server echoes it in
+// Next and Prev links, at the cost of bigger individual responses that take
+// longer to arrive and are more costly to retry. n must be between 1 and
+// MaxPageSize; zero, the default, leaves the choice to the server.
+func WithPageSize(n int) Option {
+	return func(c *Client) error {
+		if n < 0 || n > MaxPageSize {
+			return fmt.Errorf("satnogs: invalid page size %d, must be between 1 and %d, or 0 for the API default", n, MaxPageSize)
+		}
+		c.pageSize = n
+		return nil
+	}
+}
+
+// WithMaxPages limits how many pages auto-paginating helpers such as
+// GetAllTelemetry fetch for a single call. The default, zero, means no limit.
+func WithMaxPages(n int) Option {
+	return func(c *Client) error {
+		if n < 0 {
+			return fmt.Errorf("satnogs: invalid max pages %d", n)
+		}
+		c.maxPages = n
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, including
+// page fetches. The default is "go-satnogs/<version>"; an empty ua keeps it, so
+// requests always identify the library to server operators.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) error {
+		if ua == "" {
+			ua = defaultUserAgent
+		}
+		c.userAgent = ua
+		return nil
+	}
+}
+
+// WithAPIKey sets the API key, overriding the one passed to the constructor.
+// It lets an explicit key take precedence over SATNOGS_API_KEY in
+// NewClientFromEnv.
+func WithAPIKey(apiKey string) Option {
+	return func(c *Client) error {
+		c.apiKey = apiKey
+		return nil
+	}
+}
+
+// WithStrictDecoding makes the client reject API responses that contain JSON
+// fields the library does not know, instead of silently dropping them, so
+// schema changes on the server surface as errors, e.g. in a staging
+// pipeline. The error names the endpoint and the field. By default unknown
+// fields are ignored.
+func WithStrictDecoding() Option {
+	return func(c *Client) error {
+		c.strict = true
+		return nil
+	}
+}
+
+// WithRawCapture makes the client keep the exact JSON the API returned: the
+// Raw field of every page holds its body, and that of every Telemetry record
+// its own JSON object, including fields the struct does not capture. It is
+// meant for archiving; without it Raw stays nil and no copy is kept.
+func WithRawCapture() Option {
+	return func(c *Client) error {
+		c.rawCapture = true
+		return nil
+	}
+}
@@ -0,0 +1,300 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTimeoutAndHTTPClientOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		opts func(hc *http.Client) []Option
+		want time.Duration
+	}{
+		{
+			name: "timeout after client",
+			opts: func(hc *http.Client) []Option { return []Option{WithHTTPClient(hc), WithTimeout(3 * time.Second)} },
+			want: 3 * time.Second,
+		},
+		{
+			name: "timeout before client",
+			opts: func(hc *http.Client) []Option { return []Option{WithTimeout(3 * time.Second), WithHTTPClient(hc)} },
+			want: time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := &http.Client{Timeout: time.Minute}
+			c, err := New("", tt.opts(hc)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.client.Timeout != tt.want {
+				t.Errorf("client timeout = %v, want %v", c.client.Timeout, tt.want)
+			}
+			if hc.Timeout != time.Minute {
+				t.Errorf("caller's client timeout changed to %v", hc.Timeout)
+			}
+		})
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	for _, raw := range []string{
+		"",
+		"db.satnogs.org/api",
+		"ftp://db.satnogs.org/api",
+		"https:///api",
+		"https://db.satnogs.org/api?format=json",
+		"https://db.satnogs.org/api#top",
+		"https://db.satnogs.org/%zz",
+	} {
+		if _, err := New("", WithBaseURL(raw)); err == nil {
+			t.Errorf("WithBaseURL(%q) accepted", raw)
+		}
+	}
+
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		writeJSON(w, `{"count":0,"results":[]}`)
+	}))
+	defer srv.Close()
+	for _, raw := range []string{srv.URL + "/api", srv.URL + "/api/", srv.URL + "/api///"} {
+		c, err := New("", WithBaseURL(raw))
+		if err != nil {
+			t.Fatalf("WithBaseURL(%q): %v", raw, err)
+		}
+		if c.baseURL != srv.URL+"/api" {
+			t.Errorf("WithBaseURL(%q) stored %q", raw, c.baseURL)
+		}
+		if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, p := range paths {
+		if p != "/api/telemetry/" {
+			t.Errorf("request path = %q, want /api/telemetry/", p)
+		}
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{"default", nil, "go-satnogs/" + Version},
+		{"custom", []Option{WithUserAgent("groundstation/2.1")}, "groundstation/2.1"},
+		{"empty keeps default", []Option{WithUserAgent("")}, "go-satnogs/" + Version},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var agents []string
+			serve := pagedTelemetry(4)
+			c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				agents = append(agents, r.UserAgent())
+				serve(w, r)
+			}, tt.opts...)
+			if _, err := c.GetAllTelemetry(context.Background(), "AAAA-0000"); err != nil {
+				t.Fatal(err)
+			}
+			if len(agents) != 2 {
+				t.Fatalf("server saw %d requests, want 2 pages", len(agents))
+			}
+			for i, ua := range agents {
+				if ua != tt.want {
+					t.Errorf("request %d: User-Agent = %q, want %q", i, ua, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestWithHeader(t *testing.T) {
+	if _, err := New("", WithHeader("authorization", "Token stolen")); err == nil {
+		t.Error("WithHeader accepted the Authorization header")
+	}
+
+	var got []http.Header
+	serve := pagedTelemetry(4)
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Clone())
+		serve(w, r)
+	}, WithHeader("X-Org-Token", "org-secret"), WithHeader("X-Env", "staging"))
+
+	ctx := WithRequestOptions(context.Background(),
+		WithRequestHeader("X-Env", "prod"),
+		WithRequestHeader("Authorization", "Token other"))
+	if _, err := c.GetAllTelemetry(ctx, "AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("server saw %d requests, want 2 pages", len(got))
+	}
+	for i, h := range got {
+		if h.Get("X-Org-Token") != "org-secret" {
+			t.Errorf("request %d: X-Org-Token = %q", i, h.Get("X-Org-Token"))
+		}
+		if h.Get("X-Env") != "prod" {
+			t.Errorf("request %d: X-Env = %q, want the per-call value", i, h.Get("X-Env"))
+		}
+		if h.Get("Authorization") != "Token test-key" {
+			t.Errorf("request %d: Authorization = %q, want the API key", i, h.Get("Authorization"))
+		}
+	}
+}
+
+// rawRecords are telemetry objects as the server sends them, odd spacing and
+// fields the Telemetry struct does not know included.
+var rawRecords = []string{
+	`{"sat_id": "AAAA-0000",  "frame": "C0FFEE", "snr": 12.5}`,
+	`{ "sat_id":"AAAA-0000","frame":"BEEF","extra":{"nested":[1, 2]} }`,
+}
+
+func rawPayload() string {
+	return `{"count": 2, "next": null, "prev": null, "results": [` + strings.Join(rawRecords, ",\n  ") + `]}`
+}
+
+func TestRawCaptureMatchesPayload(t *testing.T) {
+	payload := rawPayload()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(payload))
+	}
+
+	for _, tt := range []struct {
+		name string
+		opts []Option
+	}{
+		{"default", nil},
+		{"tolerant", []Option{WithTolerantDecoding()}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestClient(t, handler, append(tt.opts, WithRawCapture())...)
+			page, err := c.GetTelemetryResponse("AAAA-0000")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(page.Raw) != payload {
+				t.Errorf("page Raw = %s, want the body as sent", page.Raw)
+			}
+			if len(page.Results) != len(rawRecords) {
+				t.Fatalf("got %d records, want %d", len(page.Results), len(rawRecords))
+			}
+			for i, r := range page.Results {
+				if string(r.Raw) != rawRecords[i] {
+					t.Errorf("record %d Raw = %s, want %s", i, r.Raw, rawRecords[i])
+				}
+			}
+		})
+	}
+
+	t.Run("stream", func(t *testing.T) {
+		c, _ := newTestClient(t, handler, WithRawCapture())
+		var got []string
+		err := c.StreamTelemetry(context.Background(), "AAAA-0000", func(r Telemetry) error {
+			got = append(got, string(r.Raw))
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(got, "\n") != strings.Join(rawRecords, "\n") {
+			t.Errorf("streamed Raw = %q, want %q", got, rawRecords)
+		}
+	})
+
+	t.Run("off", func(t *testing.T) {
+		c, _ := newTestClient(t, handler)
+		page, err := c.GetTelemetryResponse("AAAA-0000")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Raw != nil || page.Results[0].Raw != nil {
+			t.Error("Raw kept without WithRawCapture")
+		}
+	})
+}
+
+func TestRequestTimeoutSlowServer(t *testing.T) {
+	slow := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(2 * time.Second):
+			writeJSON(w, fullPage)
+		case <-r.Context().Done():
+		}
+	}
+
+	t.Run("shorter than the client timeout", func(t *testing.T) {
+		c, _ := newTestClient(t, slow, WithTimeout(5*time.Second), WithRequestTimeout(50*time.Millisecond))
+		start := time.Now()
+		_, err := c.GetTelemetry("AAAA-0000")
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want context.DeadlineExceeded", err)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("call took %v, want it cut off by the 50ms deadline", d)
+		}
+	})
+
+	t.Run("covers retries", func(t *testing.T) {
+		var hits atomic.Int32
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			http.Error(w, "down", http.StatusServiceUnavailable)
+		}, WithRequestTimeout(100*time.Millisecond), WithRetryConfig(RetryConfig{MaxRetries: 50, BaseDelay: 40 * time.Millisecond, MaxDelay: 40 * time.Millisecond}))
+		start := time.Now()
+		if _, err := c.GetTelemetry("AAAA-0000"); err == nil {
+			t.Fatal("GetTelemetry succeeded")
+		}
+		if d := time.Since(start); d > time.Second || hits.Load() >= 50 {
+			t.Errorf("made %d attempts in %v, want the retries cut off by the deadline", hits.Load(), d)
+		}
+	})
+
+	t.Run("call timeout takes precedence", func(t *testing.T) {
+		c, _ := newTestClient(t, slow, WithRequestTimeout(time.Minute))
+		ctx := WithRequestOptions(context.Background(), WithCallTimeout(50*time.Millisecond))
+		if _, err := c.GetTelemetryContext(ctx, "AAAA-0000"); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	if _, err := New("", WithRequestTimeout(-time.Second)); err == nil {
+		t.Error("negative request timeout accepted")
+	}
+}
+
+func TestWithPageSizeBounds(t *testing.T) {
+	for _, n := range []int{0, 1, MaxPageSize} {
+		if _, err := New("", WithPageSize(n)); err != nil {
+			t.Errorf("WithPageSize(%d): %v", n, err)
+		}
+	}
+	for _, n := range []int{-1, MaxPageSize + 1} {
+		_, err := New("", WithPageSize(n))
+		if err == nil || !strings.Contains(err.Error(), "0 for the API default") {
+			t.Errorf("WithPageSize(%d) err = %v, want it to explain 0", n, err)
+		}
+	}
+
+	var pageSize string
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pageSize = r.URL.Query().Get("page_size")
+		writeJSON(w, fullPage)
+	}, WithPageSize(0))
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	if pageSize != "" {
+		t.Errorf("page_size = %q, want none sent for 0", pageSize)
+	}
+}
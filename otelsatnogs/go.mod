@@ -0,0 +1,23 @@
+module github.com/Alatec/go-satnogs/otelsatnogs
+
+go 1.23.4
+
+require (
+	github.com/Alatec/go-satnogs v0.0.0-00010101000000-000000000000
+	go.opentelemetry.io/otel v1.34.0
+	go.opentelemetry.io/otel/sdk v1.34.0
+	go.opentelemetry.io/otel/trace v1.34.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.34.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/sys v0.29.0 // indirect
+	golang.org/x/time v0.11.0 // indirect
+)
+
+replace github.com/Alatec/go-satnogs => ../
@@ -0,0 +1,68 @@
+// Package otelsatnogs reports the operations of a gosatnogs client as
+// OpenTelemetry spans.
+//
+//	client, err := gosatnogs.New(key, otelsatnogs.WithTracerProvider(otel.GetTracerProvider()))
+//
+// Every telemetry request becomes a span named after the operation, e.g.
+// "satnogs.GetTelemetry", with page fetches of the auto-paginating helpers as
+// child spans. Spans carry the satellite ID, endpoint, page number, result
+// count and HTTP status, and record the error of a failed operation.
+//
+// The package is a module of its own, so programs that do not use
+// OpenTelemetry never download it along with gosatnogs.
+package otelsatnogs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	gosatnogs "github.com/Alatec/go-satnogs"
+)
+
+// ScopeName is the instrumentation scope of the tracer used for spans.
+const ScopeName = "github.com/Alatec/go-satnogs/otelsatnogs"
+
+// WithTracerProvider makes a client create its spans with a tracer from tp.
+func WithTracerProvider(tp trace.TracerProvider) gosatnogs.Option {
+	return gosatnogs.WithTracer(NewTracer(tp))
+}
+
+// NewTracer returns a gosatnogs.Tracer backed by a tracer from tp.
+func NewTracer(tp trace.TracerProvider) gosatnogs.Tracer {
+	return tracer{t: tp.Tracer(ScopeName, trace.WithInstrumentationVersion(gosatnogs.Version))}
+}
+
+type tracer struct {
+	t trace.Tracer
+}
+
+func (t tracer) Start(ctx context.Context, operation string) (context.Context, gosatnogs.Span) {
+	ctx, s := t.t.Start(ctx, "satnogs."+operation)
+	return ctx, span{s: s}
+}
+
+type span struct {
+	s trace.Span
+}
+
+func (s span) SetAttribute(key string, value any) {
+	switch v := value.(type) {
+	case string:
+		s.s.SetAttributes(attribute.String(key, v))
+	case int:
+		s.s.SetAttributes(attribute.Int(key, v))
+	case bool:
+		s.s.SetAttributes(attribute.Bool(key, v))
+	}
+}
+
+func (s span) End(err error) {
+	if err != nil {
+		s.s.RecordError(err)
+		s.s.SetStatus(codes.Error, err.Error())
+	}
+	s.s.End()
+}
@@ -0,0 +1,80 @@
+package otelsatnogs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	gosatnogs "github.com/Alatec/go-satnogs"
+	"github.com/Alatec/go-satnogs/otelsatnogs"
+)
+
+func TestSpans(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sat_id") == "MISSING" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count":2,"results":[{"sat_id":"AAAA-0000"},{"sat_id":"AAAA-0000"}]}`))
+	}))
+	defer srv.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer tp.Shutdown(context.Background())
+	c, err := gosatnogs.New("", gosatnogs.WithBaseURL(srv.URL), otelsatnogs.WithTracerProvider(tp))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTelemetry("MISSING"); err == nil {
+		t.Fatal("GetTelemetry for a missing satellite succeeded")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("exported %d spans, want 2", len(spans))
+	}
+	ok, failed := spans[0], spans[1]
+	if ok.Name != "satnogs.GetTelemetry" {
+		t.Errorf("span name = %q, want satnogs.GetTelemetry", ok.Name)
+	}
+	if ok.InstrumentationScope.Name != otelsatnogs.ScopeName {
+		t.Errorf("scope = %q, want %q", ok.InstrumentationScope.Name, otelsatnogs.ScopeName)
+	}
+	want := map[attribute.Key]attribute.Value{
+		gosatnogs.AttrSatelliteID: attribute.StringValue("AAAA-0000"),
+		gosatnogs.AttrEndpoint:    attribute.StringValue("/telemetry/"),
+		gosatnogs.AttrResultCount: attribute.IntValue(2),
+		gosatnogs.AttrHTTPStatus:  attribute.IntValue(http.StatusOK),
+	}
+	got := make(map[attribute.Key]attribute.Value)
+	for _, kv := range ok.Attributes {
+		got[kv.Key] = kv.Value
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("attribute %s = %v, want %v", k, got[k].Emit(), v.Emit())
+		}
+	}
+	if ok.Status.Code != codes.Unset {
+		t.Errorf("status of the successful span = %v, want unset", ok.Status.Code)
+	}
+
+	if failed.Status.Code != codes.Error {
+		t.Errorf("status of the failed span = %v, want error", failed.Status.Code)
+	}
+	if len(failed.Events) == 0 || failed.Events[0].Name != "exception" {
+		t.Errorf("failed span events = %v, want the recorded error", failed.Events)
+	}
+}
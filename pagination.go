@@ -0,0 +1,215 @@
+package gosatnogs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Page is one page of results from a paginated list endpoint. Next and Prev
+// hold the absolute URLs of the neighbouring pages, or "" at either end.
+// Count is the total number of results across all pages when the endpoint
+// reports it, and zero otherwise.
+type Page[T any] struct {
+	Count   int    `json:"count"`
+	Next    string `json:"next"`
+	Prev    string `json:"prev"`
+	Results []T    `json:"results"`
+
+	// Raw is the JSON body the page was decoded from. It is only set by
+	// clients created with WithRawCapture.
+	Raw json.RawMessage `json:"-"`
+	// Errors lists the results that could not be decoded and were left out
+	// of Results. It is only set by clients created with
+	// WithTolerantDecoding.
+	Errors []*RecordError `json:"-"`
+}
+
+// rawCapturer is implemented by decoded values that keep their raw JSON.
+type rawCapturer interface {
+	captureRaw(data []byte) error
+}
+
+// rawSetter is implemented by result types with a Raw field.
+type rawSetter interface {
+	setRaw(raw json.RawMessage)
+}
+
+// captureRaw stores data, the body p was decoded from, in p.Raw and the JSON
+// object of each result in its Raw field, if its type has one.
+func (p *Page[T]) captureRaw(data []byte) error {
+	p.Raw = data
+	if len(p.Results) == 0 {
+		return nil
+	}
+	if _, ok := any(&p.Results[0]).(rawSetter); !ok {
+		return nil
+	}
+	var page struct {
+		Results []json.RawMessage `json:"results"`
+	}
+	if err := json.Unmarshal(data, &page); err != nil {
+		return err
+	}
+	for i := range min(len(page.Results), len(p.Results)) {
+		any(&p.Results[i]).(rawSetter).setRaw(page.Results[i])
+	}
+	return nil
+}
+
+// rebaseLinks rewrites Next and Prev with c.rebasePageURL. requestURL is the
+// URL the page was fetched from.
+func (p *Page[T]) rebaseLinks(c *Client, requestURL string) {
+	p.Next = c.rebasePageURL(p.Next, requestURL)
+	p.Prev = c.rebasePageURL(p.Prev, requestURL)
+}
+
+// pageLinks is implemented by pages whose links are rebased after decoding.
+type pageLinks interface {
+	rebaseLinks(c *Client, requestURL string)
+}
+
+// WithRawPageURLs keeps the Next and Prev links of pages exactly as the server
+// returned them. By default they are resolved against the URL of the request
+// that returned the page and rebased onto the client's base URL, so that
+// links pointing at the server's canonical hostname, which may differ from a
+// proxy or path-prefixed base URL, keep going through the base URL.
+func WithRawPageURLs() Option {
+	return func(c *Client) error {
+		c.rawPageURLs = true
+		return nil
+	}
+}
+
+// rebasePageURL resolves link against requestURL and, when the result is not
+// under one of the client's base URLs, moves it onto the base URL requestURL
+// was sent to, which after a failover is the active fallback. The endpoint path and the query are kept. A link whose path
+// does not end in the endpoint of requestURL is returned resolved but
+// otherwise unchanged, for checkPageURL to judge.
+func (c *Client) rebasePageURL(link, requestURL string) string {
+	if link == "" || c.rawPageURLs {
+		return link
+	}
+	ref, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	req, err := url.Parse(requestURL)
+	if err != nil {
+		return link
+	}
+	u := req.ResolveReference(ref)
+	if i, _ := c.matchBase(u); i >= 0 {
+		return u.String()
+	}
+	i, rel := c.matchBase(req)
+	if i < 0 || !strings.HasSuffix(u.Path, rel.Path) {
+		return u.String()
+	}
+	if len(c.fallbacks) > 0 {
+		// requestURL is built on the primary; the page came from the
+		// base doFailover settled on.
+		i = c.failover.preferred(c.clock.Now())
+	}
+	rebased, err := url.Parse(c.bases()[i] + rel.Path)
+	if err != nil {
+		return u.String()
+	}
+	rebased.RawQuery = u.RawQuery
+	return rebased.String()
+}
+
+// HasNext reports whether there is a page after p.
+func (p *Page[T]) HasNext() bool {
+	return p.Next != ""
+}
+
+// HasPrev reports whether there is a page before p.
+func (p *Page[T]) HasPrev() bool {
+	return p.Prev != ""
+}
+
+// FetchNext fetches the page after p using c. Unlike NextPage it returns
+// ErrNoMorePages when p is the last page, so a loop can tell the end of the
+// results apart from an empty page:
+//
+//	for {
+//		page, err = gosatnogs.FetchNext(ctx, client, page)
+//		if errors.Is(err, gosatnogs.ErrNoMorePages) {
+//			break
+//		}
+//		...
+//	}
+func FetchNext[T any](ctx context.Context, c *Client, p *Page[T]) (*Page[T], error) {
+	if !p.HasNext() {
+		return nil, ErrNoMorePages
+	}
+	return fetchPage[T](ctx, c, p.Next)
+}
+
+// FetchPrev is like FetchNext in the other direction, returning
+// ErrNoMorePages when p is the first page.
+func FetchPrev[T any](ctx context.Context, c *Client, p *Page[T]) (*Page[T], error) {
+	if !p.HasPrev() {
+		return nil, ErrNoMorePages
+	}
+	return fetchPage[T](ctx, c, p.Prev)
+}
+
+// NextPage fetches the page after p using c. It returns nil and a nil error
+// when p is the last page, which callers must check before using the result;
+// FetchNext reports that case as ErrNoMorePages instead. A Next link outside
+// the client's API host is refused with an error matching ErrForeignURL.
+func NextPage[T any](ctx context.Context, c *Client, p *Page[T]) (*Page[T], error) {
+	if p.Next == "" {
+		return nil, nil
+	}
+	return fetchPage[T](ctx, c, p.Next)
+}
+
+// PrevPage fetches the page before p using c. It returns nil and a nil error
+// when p is the first page; FetchPrev reports that case as ErrNoMorePages
+// instead.
+func PrevPage[T any](ctx context.Context, c *Client, p *Page[T]) (*Page[T], error) {
+	if p.Prev == "" {
+		return nil, nil
+	}
+	return fetchPage[T](ctx, c, p.Prev)
+}
+
+// fetchPage fetches the page at pageURL, which must be on the client's API
+// host, so that a compromised or misconfigured server cannot make the client
+// send its API key elsewhere through a Next or Prev link.
+func fetchPage[T any](ctx context.Context, c *Client, pageURL string) (*Page[T], error) {
+	if err := c.checkPageURL(pageURL); err != nil {
+		return nil, err
+	}
+	ctx, span := c.startSpan(ctx, "FetchPage")
+	c.setURLAttributes(span, pageURL)
+	var page Page[T]
+	if err := c.getURLJSON(ctx, c.upgradeScheme(pageURL), &page); err != nil {
+		span.End(err)
+		return nil, err
+	}
+	span.SetAttribute(AttrResultCount, len(page.Results))
+	span.End(nil)
+	return &page, nil
+}
+
+// checkPageURL verifies that rawURL points at the host of the client's base
+// URL (or one of its fallbacks), so the API key is never sent to another
+// server.
+func (c *Client) checkPageURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("satnogs: invalid page URL %q: %w", rawURL, err)
+	}
+	for _, b := range c.bases() {
+		if base, err := url.Parse(b); err == nil && u.Host == base.Host {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q is not on the API host", ErrForeignURL, rawURL)
+}
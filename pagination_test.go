@@ -0,0 +1,180 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForeignPageLinksRefused(t *testing.T) {
+	var foreignHits atomic.Int32
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		foreignHits.Add(1)
+		writeJSON(w, `{"count":0,"results":[]}`)
+	}))
+	defer foreign.Close()
+	link := foreign.URL + "/collect/?page=2"
+
+	var apiHits atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		apiHits.Add(1)
+		writeJSON(w, `{"count":2,"next":"`+link+`","results":[{"sat_id":"AAAA-0000"}]}`)
+	})
+	ctx := context.Background()
+	page := &TelemetryResponse{Next: link, Prev: link}
+
+	calls := map[string]func() error{
+		"NextPage":  func() error { _, err := NextPage(ctx, c, page); return err },
+		"PrevPage":  func() error { _, err := PrevPage(ctx, c, page); return err },
+		"FetchNext": func() error { _, err := FetchNext(ctx, c, page); return err },
+		"FetchPrev": func() error { _, err := FetchPrev(ctx, c, page); return err },
+		"GetTelemetryResponseAt": func() error {
+			_, err := c.GetTelemetryResponseAt(link)
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, ErrForeignURL) {
+			t.Errorf("%s: err = %v, want ErrForeignURL", name, err)
+		}
+	}
+	if n := apiHits.Load(); n != 0 {
+		t.Errorf("API server saw %d requests for foreign links, want 0", n)
+	}
+
+	var records int
+	err := c.StreamTelemetry(ctx, "AAAA-0000", func(Telemetry) error {
+		records++
+		return nil
+	})
+	if !errors.Is(err, ErrForeignURL) {
+		t.Errorf("StreamTelemetry: err = %v, want ErrForeignURL", err)
+	}
+	if records != 1 {
+		t.Errorf("StreamTelemetry delivered %d records, want the 1 on the first page", records)
+	}
+	if n := apiHits.Load(); n != 1 {
+		t.Errorf("API server saw %d requests, want only the first page", n)
+	}
+	if n := foreignHits.Load(); n != 0 {
+		t.Errorf("foreign server saw %d requests, want 0", n)
+	}
+}
+
+// walkPages follows Next links from first with FetchNext until
+// ErrNoMorePages and returns every result.
+func walkPages[T any](t *testing.T, c *Client, first *Page[T]) []T {
+	t.Helper()
+	all := first.Results
+	for page := first; ; {
+		next, err := FetchNext(context.Background(), c, page)
+		if errors.Is(err, ErrNoMorePages) {
+			return all
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		all = append(all, next.Results...)
+		page = next
+	}
+}
+
+func TestPageElementTypes(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		next := `"http://` + r.Host + r.URL.Path + `?page=2"`
+		if r.URL.Query().Get("page") == "2" {
+			next = "null"
+		}
+		switch r.URL.Path {
+		case "/satellites/":
+			writeJSON(w, `{"next":`+next+`,"results":[{"sat_id":"SAT-`+r.URL.Query().Get("page")+`"}]}`)
+		case "/transmitters/":
+			writeJSON(w, `{"next":`+next+`,"results":[{"uuid":"TX-`+r.URL.Query().Get("page")+`","alive":true}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	sats := walkPages(t, c, &SatelliteResponse{Next: srv.URL + "/satellites/?page=1"})
+	if len(sats) != 2 || sats[0].SatID != "SAT-1" || sats[1].SatID != "SAT-2" {
+		t.Errorf("satellites = %+v, want SAT-1 and SAT-2", sats)
+	}
+	txs := walkPages(t, c, &TransmitterResponse{Next: srv.URL + "/transmitters/?page=1"})
+	if len(txs) != 2 || txs[0].UUID != "TX-1" || txs[1].UUID != "TX-2" || !txs[1].Alive {
+		t.Errorf("transmitters = %+v, want TX-1 and TX-2", txs)
+	}
+
+	last := &TransmitterResponse{}
+	if p, err := NextPage(context.Background(), c, last); p != nil || err != nil {
+		t.Errorf("NextPage on the last page = %v, %v, want nil, nil", p, err)
+	}
+	if _, err := FetchPrev(context.Background(), c, last); !errors.Is(err, ErrNoMorePages) {
+		t.Errorf("FetchPrev on the first page: err = %v, want ErrNoMorePages", err)
+	}
+}
+
+func TestRebasePageLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		next string
+	}{
+		{"canonical host", "https://db.satnogs.org/api/telemetry/?format=json&page=2"},
+		{"relative query", "?format=json&page=2"},
+		{"relative path", "/proxy/api/telemetry/?format=json&page=2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+				if r.URL.Query().Get("page") == "2" {
+					writeJSON(w, `{"next":null,"results":[{"sat_id":"PAGE-2"}]}`)
+					return
+				}
+				writeJSON(w, `{"next":"`+tt.next+`","results":[{"sat_id":"PAGE-1"}]}`)
+			}))
+			defer srv.Close()
+			c, err := New("", WithBaseURL(srv.URL+"/proxy/api/"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			page, err := c.GetTelemetryResponse("AAAA-0000")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := srv.URL + "/proxy/api/telemetry/?format=json&page=2"; page.Next != want {
+				t.Errorf("Next = %q, want %q", page.Next, want)
+			}
+			if page, err = NextPage(context.Background(), c, page); err != nil {
+				t.Fatal(err)
+			}
+			if len(page.Results) != 1 || page.Results[0].SatID != "PAGE-2" {
+				t.Errorf("second page = %+v", page.Results)
+			}
+			if len(paths) != 2 || paths[1] != "/proxy/api/telemetry/?format=json&page=2" {
+				t.Errorf("requests = %q, want the second page through the proxy prefix", paths)
+			}
+		})
+	}
+}
+
+func TestRawPageURLs(t *testing.T) {
+	const canonical = "https://db.satnogs.org/api/telemetry/?page=2"
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"next":"`+canonical+`","results":[]}`)
+	}, WithRawPageURLs())
+	page, err := c.GetTelemetryResponse("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Next != canonical {
+		t.Errorf("Next = %q, want it unchanged", page.Next)
+	}
+	if _, err := NextPage(context.Background(), c, page); !errors.Is(err, ErrForeignURL) {
+		t.Errorf("NextPage: err = %v, want ErrForeignURL", err)
+	}
+}
@@ -0,0 +1,39 @@
+package gosatnogs
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Params holds the query parameters of a request. It can be used with Get to
+// reach endpoints and filters the library does not wrap yet. A nil Params is
+// valid to pass to Get but must be created with make or a literal before
+// calling its setters.
+type Params url.Values
+
+// Set sets key to value, replacing any existing values.
+func (p Params) Set(key, value string) {
+	url.Values(p).Set(key, value)
+}
+
+// Add appends value to key.
+func (p Params) Add(key, value string) {
+	url.Values(p).Add(key, value)
+}
+
+// SetInt sets key to the decimal form of n.
+func (p Params) SetInt(key string, n int) {
+	p.Set(key, strconv.Itoa(n))
+}
+
+// SetTime sets key to t in the ISO 8601 UTC form the SatNOGS API expects for
+// time filters, e.g. "2024-01-02T15:04:05Z".
+func (p Params) SetTime(key string, t time.Time) {
+	p.Set(key, t.UTC().Format("2006-01-02T15:04:05Z"))
+}
+
+// Get returns the first value set for key, or "".
+func (p Params) Get(key string) string {
+	return url.Values(p).Get(key)
+}
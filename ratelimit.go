@@ -0,0 +1,48 @@
+package gosatnogs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit limits the client to r requests per second on average,
+// allowing bursts of up to burst requests. Every outgoing request, including
+// page fetches and retries, waits on the limiter, which blocks until a slot is
+// free or the request's context is cancelled. The limit is shared by all
+// goroutines using the Client.
+func WithRateLimit(r rate.Limit, burst int) Option {
+	return func(c *Client) error {
+		if r <= 0 {
+			return fmt.Errorf("satnogs: invalid rate limit %v", r)
+		}
+		if burst < 1 {
+			return fmt.Errorf("satnogs: invalid rate limit burst %d", burst)
+		}
+		c.limiter = rate.NewLimiter(r, burst)
+		return nil
+	}
+}
+
+// waitLimiter blocks until the rate limiter has a slot for one request or ctx
+// is done. Time is taken from the client's clock, so tests can drive the
+// limiter without real delays.
+func (c *Client) waitLimiter(ctx context.Context) error {
+	now := c.clock.Now()
+	r := c.limiter.ReserveN(now, 1)
+	d := r.DelayFrom(now)
+	if d == 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		r.CancelAt(now)
+		return fmt.Errorf("satnogs: rate limit wait of %v would exceed the context deadline: %w", d, context.DeadlineExceeded)
+	}
+	if err := c.clock.Sleep(ctx, d); err != nil {
+		r.CancelAt(c.clock.Now())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitTiming(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  float64
+		burst int
+		want  []time.Duration
+	}{
+		{"steady", 2, 1, []time.Duration{500 * time.Millisecond, 500 * time.Millisecond, 500 * time.Millisecond}},
+		{"burst", 1, 3, []time.Duration{time.Second}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := newFakeClock()
+			c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, fullPage)
+			}, withClock(clk), WithRateLimit(rate.Limit(tt.rate), tt.burst))
+			for range 4 {
+				if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if sleeps := clk.Sleeps(); !slices.Equal(sleeps, tt.want) {
+				t.Errorf("waited %v, want %v", sleeps, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitPageFetches(t *testing.T) {
+	clk := newFakeClock()
+	c, _ := newTestClient(t, pagedTelemetry(6), withClock(clk), WithRateLimit(rate.Limit(1), 1))
+	all, err := c.GetAllTelemetry(context.Background(), "AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 6 {
+		t.Errorf("got %d records, want 6", len(all))
+	}
+	if sleeps := clk.Sleeps(); len(sleeps) != 2 {
+		t.Errorf("waited %v, want once before each of the 2 later pages", sleeps)
+	}
+}
+
+func TestRateLimitDeadline(t *testing.T) {
+	var hits atomic.Int32
+	clk := newFakeClock()
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		writeJSON(w, fullPage)
+	}, withClock(clk), WithRateLimit(rate.Every(time.Hour), 1))
+
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if _, err := c.GetTelemetryContext(ctx, "AAAA-0000"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("server saw %d requests, want 1", n)
+	}
+	if sleeps := clk.Sleeps(); len(sleeps) != 0 {
+		t.Errorf("waited %v past the deadline", sleeps)
+	}
+}
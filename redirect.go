@@ -0,0 +1,51 @@
+package gosatnogs
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// maxRedirects matches the limit of http.Client's default redirect policy.
+const maxRedirects = 10
+
+// redirectPolicy wraps the CheckRedirect of the client's http.Client so the
+// API key survives redirects between the configured API hosts, e.g. from
+// http to https, which net/http may treat as cross-origin and strip the
+// Authorization header on. Redirects to any other host never carry the key.
+// next, the caller's own policy, still has the final say.
+func (c *Client) redirectPolicy(next func(*http.Request, []*http.Request) error) func(*http.Request, []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if next == nil && len(via) >= maxRedirects {
+			return errors.New("stopped after 10 redirects")
+		}
+		if auth := via[0].Header.Get("Authorization"); auth != "" {
+			if i, _ := c.matchBase(req.URL); i >= 0 {
+				req.Header.Set("Authorization", auth)
+			} else {
+				req.Header.Del("Authorization")
+			}
+		}
+		if next != nil {
+			return next(req, via)
+		}
+		return nil
+	}
+}
+
+// upgradeScheme rewrites an http page URL on an API host whose base URL uses
+// https to https, so pagination links the server hands out with the wrong
+// scheme do not go through a redirect.
+func (c *Client) upgradeScheme(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "http" {
+		return rawURL
+	}
+	for _, b := range c.bases() {
+		if base, err := url.Parse(b); err == nil && base.Scheme == "https" && base.Host == u.Host {
+			u.Scheme = "https"
+			return u.String()
+		}
+	}
+	return rawURL
+}
@@ -0,0 +1,46 @@
+package gosatnogs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedirectAuthorization(t *testing.T) {
+	var foreignAuth string
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		foreignAuth = r.Header.Get("Authorization")
+		writeJSON(w, `{"count":0,"results":[]}`)
+	}))
+	defer foreign.Close()
+
+	var movedAuth string
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/telemetry/" && r.URL.Query().Get("sat_id") == "LOCAL":
+			http.Redirect(w, r, "/moved/telemetry/?"+r.URL.RawQuery, http.StatusFound)
+		case r.URL.Path == "/telemetry/":
+			http.Redirect(w, r, foreign.URL+"/telemetry/?"+r.URL.RawQuery, http.StatusFound)
+		case strings.HasPrefix(r.URL.Path, "/moved/"):
+			movedAuth = r.Header.Get("Authorization")
+			writeJSON(w, `{"count":0,"results":[]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	if _, err := c.GetTelemetry("LOCAL"); err != nil {
+		t.Fatalf("redirect on the API host: %v", err)
+	}
+	if movedAuth != "Token test-key" {
+		t.Errorf("Authorization after redirect on the API host = %q, want the token", movedAuth)
+	}
+
+	if _, err := c.GetTelemetry("FOREIGN"); err != nil {
+		t.Fatalf("redirect to a foreign host: %v", err)
+	}
+	if foreignAuth != "" {
+		t.Errorf("Authorization after redirect to a foreign host = %q, want none", foreignAuth)
+	}
+}
@@ -0,0 +1,94 @@
+package gosatnogs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RequestOption adjusts a single call rather than the whole Client. Attach
+// request options to the context passed to a ...Context method with
+// WithRequestOptions.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	timeout time.Duration
+	header  http.Header
+	// noClientTimeout lifts the http.Client timeout for the call, leaving it
+	// bounded by its context alone.
+	noClientTimeout bool
+	// noCredentials leaves the Authorization header off, for URLs outside
+	// the API hosts.
+	noCredentials bool
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a context carrying opts in addition to any
+// request options already attached to ctx. Every request made with the
+// returned context applies them.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	ro := requestOptionsFrom(ctx)
+	ro.header = ro.header.Clone()
+	for _, opt := range opts {
+		opt(&ro)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, ro)
+}
+
+func requestOptionsFrom(ctx context.Context) requestOptions {
+	ro, _ := ctx.Value(requestOptionsKey{}).(requestOptions)
+	return ro
+}
+
+// WithCallTimeout bounds each call made with the context to d, replacing the
+// client-wide timeout for that call only. d may be shorter or longer than the
+// client's timeout; if the context already has an earlier deadline, that
+// deadline still wins. The timeout covers the whole call, including retries
+// and reading the response body.
+func WithCallTimeout(d time.Duration) RequestOption {
+	return func(ro *requestOptions) {
+		ro.timeout = d
+	}
+}
+
+// WithRequestHeader sets the header key to value on the requests of a call,
+// including the page fetches of auto-paginating helpers, e.g. a correlation
+// ID for tracing. It overrides a header of the same name set with WithHeader.
+// The Authorization header is reserved for the API key and is ignored.
+func WithRequestHeader(key, value string) RequestOption {
+	return func(ro *requestOptions) {
+		if ro.header == nil {
+			ro.header = make(http.Header)
+		}
+		ro.header.Set(key, value)
+	}
+}
+
+// withoutClientTimeout makes the requests of a call ignore the timeout of
+// the client's http.Client, for downloads whose size is unknown up front.
+func withoutClientTimeout() RequestOption {
+	return func(ro *requestOptions) {
+		ro.noClientTimeout = true
+	}
+}
+
+// withoutCredentials makes the requests of a call go out without the API key.
+func withoutCredentials() RequestOption {
+	return func(ro *requestOptions) {
+		ro.noCredentials = true
+	}
+}
+
+// cancelBody releases a per-call context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
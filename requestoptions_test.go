@@ -0,0 +1,61 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCallTimeout(t *testing.T) {
+	slow := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		writeJSON(w, fullPage)
+	}
+
+	t.Run("client timeout applies by default", func(t *testing.T) {
+		c, _ := newTestClient(t, slow, WithTimeout(50*time.Millisecond))
+		if _, err := c.GetTelemetry("AAAA-0000"); err == nil {
+			t.Fatal("request outlasting the client timeout succeeded")
+		}
+	})
+
+	t.Run("longer than the client's", func(t *testing.T) {
+		c, _ := newTestClient(t, slow, WithTimeout(50*time.Millisecond))
+		ctx := WithRequestOptions(context.Background(), WithCallTimeout(5*time.Second))
+		if _, err := c.GetTelemetryContext(ctx, "AAAA-0000"); err != nil {
+			t.Fatalf("call timeout did not replace the client timeout: %v", err)
+		}
+		if c.client.Timeout != 50*time.Millisecond {
+			t.Errorf("client timeout changed to %v", c.client.Timeout)
+		}
+	})
+
+	t.Run("shorter than the client's", func(t *testing.T) {
+		c, _ := newTestClient(t, slow, WithTimeout(5*time.Second))
+		ctx := WithRequestOptions(context.Background(), WithCallTimeout(50*time.Millisecond))
+		start := time.Now()
+		_, err := c.GetTelemetryContext(ctx, "AAAA-0000")
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+		}
+		if d := time.Since(start); d > 150*time.Millisecond {
+			t.Errorf("call took %v despite a 50ms call timeout", d)
+		}
+	})
+
+	t.Run("earlier context deadline wins", func(t *testing.T) {
+		c, _ := newTestClient(t, slow)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		ctx = WithRequestOptions(ctx, WithCallTimeout(5*time.Second))
+		if _, err := c.GetTelemetryContext(ctx, "AAAA-0000"); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+		}
+	})
+}
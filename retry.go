@@ -0,0 +1,217 @@
+package gosatnogs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryConfig controls how requests are retried after transient failures.
+// Only idempotent requests (GET and HEAD) are retried. When a response being
+// retried carries a Retry-After header, the client waits for the delay it
+// names instead of its own backoff. A 200 response whose JSON body arrives
+// truncated or malformed is requested again as well, up to MaxRetries times.
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt. Zero
+	// disables retrying.
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry. It doubles on every
+	// subsequent retry. Defaults to 500ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff between two attempts. Defaults to 30s.
+	MaxDelay time.Duration
+	// MaxRateLimitRetries is the number of times a 429 Too Many Requests
+	// response is retried in addition to MaxRetries. Once both are used up
+	// the 429 is returned as an *APIError matching ErrRateLimited.
+	MaxRateLimitRetries int
+}
+
+const (
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+// WithRetries enables retrying of 5xx and 429 responses, connection resets
+// and timeouts up to max times, using exponential backoff with jitter. Use
+// WithRetryConfig to change the delays as well.
+func WithRetries(max int) Option {
+	return WithRetryConfig(RetryConfig{MaxRetries: max})
+}
+
+// WithRetry is like WithRetries but counts attempts rather than retries:
+// at most maxAttempts attempts are made in total, waiting roughly baseDelay
+// before the first retry. It is shorthand for WithRetryConfig.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Client) error {
+		if maxAttempts < 1 {
+			return fmt.Errorf("satnogs: invalid retry attempts %d", maxAttempts)
+		}
+		return WithRetryConfig(RetryConfig{MaxRetries: maxAttempts - 1, BaseDelay: baseDelay})(c)
+	}
+}
+
+// WithRetryConfig enables retrying with the given configuration. Zero delays
+// are replaced with their defaults; negative counts or delays are rejected.
+func WithRetryConfig(cfg RetryConfig) Option {
+	return func(c *Client) error {
+		if cfg.MaxRetries < 0 || cfg.MaxRateLimitRetries < 0 || cfg.BaseDelay < 0 || cfg.MaxDelay < 0 {
+			return fmt.Errorf("satnogs: invalid retry config %+v", cfg)
+		}
+		if cfg.MaxRateLimitRetries == 0 {
+			cfg.MaxRateLimitRetries = c.retry.MaxRateLimitRetries
+		}
+		c.retry = cfg
+		return nil
+	}
+}
+
+// WithRateLimitRetries makes the client wait out 429 responses and try again,
+// up to max times per request. The wait honours the Retry-After header (in
+// either its seconds or HTTP-date form), falling back to the retry backoff
+// when the header is absent.
+func WithRateLimitRetries(max int) Option {
+	return func(c *Client) error {
+		if max < 0 {
+			return fmt.Errorf("satnogs: invalid rate limit retries %d", max)
+		}
+		c.retry.MaxRateLimitRetries = max
+		return nil
+	}
+}
+
+// backoff returns the delay before retry number n (starting at 0): the base
+// delay doubled n times, capped at MaxDelay, with up to half of it replaced by
+// random jitter.
+func (r RetryConfig) backoff(n int) time.Duration {
+	base, max := r.BaseDelay, r.MaxDelay
+	if base <= 0 {
+		base = defaultRetryBaseDelay
+	}
+	if max <= 0 {
+		max = defaultRetryMaxDelay
+	}
+	d := base
+	for i := 0; i < n && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// idempotent reports whether req may safely be sent more than once.
+func idempotent(req *http.Request) bool {
+	return req.Method == http.MethodGet || req.Method == http.MethodHead
+}
+
+// retryable reports whether err is a transient failure worth another attempt.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// truncatedBody reports whether err, met while reading or decoding a
+// successful response, suggests the body was cut off in transit.
+func truncatedBody(err error) bool {
+	var syntaxErr *json.SyntaxError
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &syntaxErr)
+}
+
+// do sends req, retrying transient failures and rate limited responses
+// according to the client's retry configuration. Non-2xx responses are turned
+// into an *APIError. When retries are exhausted, or the next wait would
+// outlast the request's context deadline, the last error is returned.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	retries, rateLimitRetries := 0, 0
+	for {
+		if c.limiter != nil {
+			if err := c.waitLimiter(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if c.breaker != nil {
+			if err := c.breaker.allow(); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := c.sendHedged(req, retries+rateLimitRetries+1)
+		if err == nil {
+			err = c.checkResponse(req, resp)
+		}
+		if c.breaker != nil {
+			c.breaker.record(ctx, err)
+		}
+		if err == nil {
+			return resp, nil
+		}
+
+		var delay time.Duration
+		var apiErr *APIError
+		isAPIErr := errors.As(err, &apiErr)
+		switch {
+		case !idempotent(req) || ctx.Err() != nil:
+			return nil, err
+		case isAPIErr && apiErr.StatusCode == http.StatusTooManyRequests:
+			switch {
+			case rateLimitRetries < c.retry.MaxRateLimitRetries:
+				delay = c.retry.backoff(rateLimitRetries)
+				rateLimitRetries++
+			case retries < c.retry.MaxRetries:
+				delay = c.retry.backoff(retries)
+				retries++
+			default:
+				return nil, err
+			}
+		case retries < c.retry.MaxRetries && retryable(ctx, err):
+			delay = c.retry.backoff(retries)
+			retries++
+		default:
+			return nil, err
+		}
+		if isAPIErr && apiErr.RetryAfter > 0 {
+			delay = apiErr.RetryAfter
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return nil, err
+		}
+		if sleepErr := c.clock.Sleep(ctx, delay); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	truncatedPage = `{"count":1,"results":[{"sat_id":"AAAA`
+	fullPage      = `{"count":1,"results":[{"sat_id":"AAAA-0000"}]}`
+)
+
+func TestTruncatedBodyRetry(t *testing.T) {
+	t.Run("truncated then full", func(t *testing.T) {
+		var hits atomic.Int32
+		clk := newFakeClock()
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if hits.Add(1) == 1 {
+				writeJSON(w, truncatedPage)
+				return
+			}
+			writeJSON(w, fullPage)
+		}, WithRetries(2), withClock(clk))
+
+		got, err := c.GetTelemetry("AAAA-0000")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 || got[0].SatID != "AAAA-0000" {
+			t.Errorf("GetTelemetry = %+v, want the full page", got)
+		}
+		if n := hits.Load(); n != 2 {
+			t.Errorf("server saw %d requests, want 2", n)
+		}
+		if n := len(clk.Sleeps()); n != 1 {
+			t.Errorf("slept %d times, want 1", n)
+		}
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		var hits atomic.Int32
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			writeJSON(w, truncatedPage)
+		}, WithRetries(2), withClock(newFakeClock()))
+
+		_, err := c.GetTelemetry("AAAA-0000")
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("err = %v, want io.ErrUnexpectedEOF", err)
+		}
+		if n := hits.Load(); n != 3 {
+			t.Errorf("server saw %d requests, want 3", n)
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		var hits atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		clk := newFakeClock()
+		clk.onSleep = cancel
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			writeJSON(w, truncatedPage)
+		}, WithRetries(5), withClock(clk))
+
+		if _, err := c.GetTelemetryContext(ctx, "AAAA-0000"); err == nil {
+			t.Fatal("err = nil, want the truncation error")
+		}
+		if n := hits.Load(); n != 1 {
+			t.Errorf("server saw %d requests after cancellation, want 1", n)
+		}
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Run("recovers after 5xx", func(t *testing.T) {
+		var hits atomic.Int32
+		clk := newFakeClock()
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if hits.Add(1) <= 2 {
+				http.Error(w, "deploying", http.StatusBadGateway)
+				return
+			}
+			writeJSON(w, fullPage)
+		}, WithRetryConfig(RetryConfig{MaxRetries: 3, BaseDelay: 100 * time.Millisecond}), withClock(clk))
+
+		if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+			t.Fatal(err)
+		}
+		sleeps := clk.Sleeps()
+		if len(sleeps) != 2 {
+			t.Fatalf("slept %v, want 2 backoffs", sleeps)
+		}
+		for i, d := range sleeps {
+			max := 100 * time.Millisecond << i
+			if d < max/2 || d > max {
+				t.Errorf("backoff %d = %v, want between %v and %v", i, d, max/2, max)
+			}
+		}
+	})
+
+	t.Run("exhausted", func(t *testing.T) {
+		var hits atomic.Int32
+		clk := newFakeClock()
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			http.Error(w, "down", http.StatusServiceUnavailable)
+		}, WithRetries(3), withClock(clk))
+
+		_, err := c.GetTelemetry("AAAA-0000")
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("err = %v, want the last 503", err)
+		}
+		if n := hits.Load(); n != 4 {
+			t.Errorf("server saw %d requests, want 4", n)
+		}
+		if n := len(clk.Sleeps()); n != 3 {
+			t.Errorf("slept %d times, want 3", n)
+		}
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		var hits atomic.Int32
+		clk := newFakeClock()
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			http.NotFound(w, r)
+		}, WithRetries(3), withClock(clk))
+
+		if _, err := c.GetTelemetry("AAAA-0000"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("err = %v, want ErrNotFound", err)
+		}
+		if n := hits.Load(); n != 1 {
+			t.Errorf("server saw %d requests, want 1", n)
+		}
+	})
+
+	t.Run("deadline shorter than backoff", func(t *testing.T) {
+		var hits atomic.Int32
+		clk := newFakeClock()
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			http.Error(w, "down", http.StatusServiceUnavailable)
+		}, WithRetryConfig(RetryConfig{MaxRetries: 3, BaseDelay: time.Hour, MaxDelay: time.Hour}), withClock(clk))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if _, err := c.GetTelemetryContext(ctx, "AAAA-0000"); !errors.Is(err, ErrServer) {
+			t.Errorf("err = %v, want the 503", err)
+		}
+		if n := hits.Load(); n != 1 {
+			t.Errorf("server saw %d requests, want 1", n)
+		}
+		if sleeps := clk.Sleeps(); len(sleeps) != 0 {
+			t.Errorf("slept %v past the deadline", sleeps)
+		}
+	})
+}
+
+func TestBackoffBounds(t *testing.T) {
+	cfg := RetryConfig{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for n, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		for range 100 {
+			if d := cfg.backoff(n); d < max/2 || d > max {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", n, d, max/2, max)
+			}
+		}
+	}
+}
+
+func TestRetryAfterWithFakeClock(t *testing.T) {
+	clk := newFakeClock()
+	retryAt := clk.Now().Add(2 * time.Minute).Format(http.TimeFormat)
+	var hits atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch hits.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set("Retry-After", retryAt)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			writeJSON(w, fullPage)
+		}
+	}, WithRetries(1), WithRateLimitRetries(1), withClock(clk))
+
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	sleeps := clk.Sleeps()
+	want := []time.Duration{7 * time.Second, 2*time.Minute - 7*time.Second}
+	if len(sleeps) != len(want) || sleeps[0] != want[0] || sleeps[1] != want[1] {
+		t.Errorf("slept %v, want %v", sleeps, want)
+	}
+}
+
+func TestRetryConfigValidation(t *testing.T) {
+	for _, cfg := range []RetryConfig{
+		{MaxRetries: -1},
+		{MaxRetries: 3, BaseDelay: -time.Second},
+		{MaxRetries: 3, MaxDelay: -time.Second},
+		{MaxRateLimitRetries: -1},
+	} {
+		if _, err := New("", WithRetryConfig(cfg)); err == nil {
+			t.Errorf("WithRetryConfig(%+v) accepted", cfg)
+		}
+	}
+	c, err := New("", WithRetryConfig(RetryConfig{MaxRetries: 4, BaseDelay: time.Second}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.retry.MaxRetries != 4 || c.retry.BaseDelay != time.Second {
+		t.Errorf("retry config = %+v", c.retry)
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	c, err := New("", WithRetry(3, 2*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.retry.MaxRetries != 2 || c.retry.BaseDelay != 2*time.Second {
+		t.Errorf("retry config = %+v, want 2 retries after 2s", c.retry)
+	}
+	for _, attempts := range []int{0, -1} {
+		if _, err := New("", WithRetry(attempts, time.Second)); err == nil {
+			t.Errorf("WithRetry(%d, 1s) accepted", attempts)
+		}
+	}
+	if _, err := New("", WithRetry(2, -time.Second)); err == nil {
+		t.Error("WithRetry with a negative delay accepted")
+	}
+}
+
+func TestWithRateLimitRetriesValidation(t *testing.T) {
+	if _, err := New("", WithRateLimitRetries(-1)); err == nil {
+		t.Error("WithRateLimitRetries(-1) accepted")
+	}
+	c, err := New("", WithRateLimitRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.retry.MaxRateLimitRetries != 0 {
+		t.Errorf("MaxRateLimitRetries = %d, want 0", c.retry.MaxRateLimitRetries)
+	}
+}
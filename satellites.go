@@ -0,0 +1,126 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Satellite status values reported by the SatNOGS DB.
+const (
+	SatelliteStatusAlive     = "alive"
+	SatelliteStatusDead      = "dead"
+	SatelliteStatusReentered = "re-entered"
+	SatelliteStatusFuture    = "future"
+)
+
+type Satellite struct {
+	SatID      string     `json:"sat_id"`
+	NoradCatID int        `json:"norad_cat_id"`
+	Name       string     `json:"name"`
+	Names      string     `json:"names"`
+	Image      string     `json:"image"`
+	Status     string     `json:"status"`
+	Decayed    *time.Time `json:"decayed"`
+	Launched   *time.Time `json:"launched"`
+	Deployed   *time.Time `json:"deployed"`
+	Website    string     `json:"website"`
+	Operator   string     `json:"operator"`
+	Countries  string     `json:"countries"`
+	Updated    time.Time  `json:"updated"`
+}
+
+// SatelliteResponse is a page of satellites.
+type SatelliteResponse = Page[Satellite]
+
+// SatelliteFilter narrows the satellites returned by GetSatellites. Zero values
+// are not sent to the API.
+type SatelliteFilter struct {
+	// Status is one of the SatelliteStatus constants.
+	Status string
+	// InOrbit, when non-nil, restricts results to satellites that are (or are
+	// not) currently in orbit.
+	InOrbit *bool
+}
+
+func (f SatelliteFilter) params() Params {
+	params := Params{"format": {"json"}}
+	if f.Status != "" {
+		params.Set("status", f.Status)
+	}
+	if f.InOrbit != nil {
+		params.Set("in_orbit", strconv.FormatBool(*f.InOrbit))
+	}
+	return params
+}
+
+// GetSatellites retrieves the first page of satellites matching filter. Use
+// GetSatelliteResponse to follow pagination. When nothing matches, the result
+// is an empty slice and a nil error.
+func (c *Client) GetSatellites(filter SatelliteFilter) ([]Satellite, error) {
+	return c.GetSatellitesContext(context.Background(), filter)
+}
+
+// GetSatellitesContext is like GetSatellites but binds the request to ctx.
+func (c *Client) GetSatellitesContext(ctx context.Context, filter SatelliteFilter) ([]Satellite, error) {
+	resp, err := c.GetSatelliteResponseContext(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// GetSatellite retrieves a single satellite by its SatNOGS satellite ID. If
+// the DB does not know it, the error matches ErrSatelliteNotFound.
+func (c *Client) GetSatellite(satID string) (*Satellite, error) {
+	return c.GetSatelliteContext(context.Background(), satID)
+}
+
+// GetSatelliteContext is like GetSatellite but binds the request to ctx.
+func (c *Client) GetSatelliteContext(ctx context.Context, satID string) (*Satellite, error) {
+	var satellite Satellite
+	err := c.getJSON(ctx, "/satellites/"+url.PathEscape(satID)+"/", Params{"format": {"json"}}, &satellite)
+	if errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s: %w", ErrSatelliteNotFound, satID, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &satellite, nil
+}
+
+func (c *Client) GetSatelliteResponse(filter SatelliteFilter) (*SatelliteResponse, error) {
+	return c.GetSatelliteResponseContext(context.Background(), filter)
+}
+
+// GetSatelliteResponseContext is like GetSatelliteResponse but binds the request to ctx.
+func (c *Client) GetSatelliteResponseContext(ctx context.Context, filter SatelliteFilter) (*SatelliteResponse, error) {
+	var satelliteResponse SatelliteResponse
+	if err := c.getJSON(ctx, "/satellites/", filter.params(), &satelliteResponse); err != nil {
+		return nil, err
+	}
+	return &satelliteResponse, nil
+}
+
+func (c *Client) GetSatelliteResponseNextPage(s *SatelliteResponse) (*SatelliteResponse, error) {
+	return c.GetSatelliteResponseNextPageContext(context.Background(), s)
+}
+
+// GetSatelliteResponseNextPageContext is like GetSatelliteResponseNextPage but binds
+// the request to ctx.
+func (c *Client) GetSatelliteResponseNextPageContext(ctx context.Context, s *SatelliteResponse) (*SatelliteResponse, error) {
+	return NextPage(ctx, c, s)
+}
+
+func (c *Client) GetSatelliteResponsePrevPage(s *SatelliteResponse) (*SatelliteResponse, error) {
+	return c.GetSatelliteResponsePrevPageContext(context.Background(), s)
+}
+
+// GetSatelliteResponsePrevPageContext is like GetSatelliteResponsePrevPage but binds
+// the request to ctx.
+func (c *Client) GetSatelliteResponsePrevPageContext(ctx context.Context, s *SatelliteResponse) (*SatelliteResponse, error) {
+	return PrevPage(ctx, c, s)
+}
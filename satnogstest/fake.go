@@ -0,0 +1,154 @@
+// Package satnogstest provides test doubles for code that uses the
+// gosatnogs client.
+package satnogstest
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+
+	gosatnogs "github.com/Alatec/go-satnogs"
+)
+
+// DefaultPageSize is the number of records per page a Fake serves unless
+// PageSize is set.
+const DefaultPageSize = 25
+
+// Fake is an in-memory gosatnogs.TelemetryAPI. It serves canned telemetry,
+// split into pages linked by Next and Prev, and can be told to fail specific
+// calls. It is safe for concurrent use.
+type Fake struct {
+	// PageSize is the number of records per page. Zero means DefaultPageSize.
+	PageSize int
+
+	mu        sync.Mutex
+	telemetry map[string][]gosatnogs.Telemetry
+	failures  map[int]error
+	calls     int
+}
+
+var _ gosatnogs.TelemetryAPI = (*Fake)(nil)
+
+// NewFake returns an empty Fake. Satellites without telemetry set return an
+// empty first page.
+func NewFake() *Fake {
+	return &Fake{
+		telemetry: make(map[string][]gosatnogs.Telemetry),
+		failures:  make(map[int]error),
+	}
+}
+
+// SetTelemetry sets the records served for satelliteID, newest first like the
+// real API.
+func (f *Fake) SetTelemetry(satelliteID string, records []gosatnogs.Telemetry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.telemetry[satelliteID] = records
+}
+
+// FailOnCall makes the nth call (counting from 1 across all methods) return
+// err instead of data.
+func (f *Fake) FailOnCall(n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures[n] = err
+}
+
+// Calls returns how many calls the Fake has served.
+func (f *Fake) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func (f *Fake) GetTelemetry(satelliteID string) ([]gosatnogs.Telemetry, error) {
+	return f.GetTelemetryContext(context.Background(), satelliteID)
+}
+
+func (f *Fake) GetTelemetryContext(ctx context.Context, satelliteID string) ([]gosatnogs.Telemetry, error) {
+	page, err := f.GetTelemetryResponseContext(ctx, satelliteID)
+	if err != nil {
+		return nil, err
+	}
+	return page.Results, nil
+}
+
+func (f *Fake) GetTelemetryResponse(satelliteID string) (*gosatnogs.TelemetryResponse, error) {
+	return f.GetTelemetryResponseContext(context.Background(), satelliteID)
+}
+
+func (f *Fake) GetTelemetryResponseContext(ctx context.Context, satelliteID string) (*gosatnogs.TelemetryResponse, error) {
+	return f.page(ctx, satelliteID, 0)
+}
+
+func (f *Fake) GetTelemetryResponseNextPage(t *gosatnogs.TelemetryResponse) (*gosatnogs.TelemetryResponse, error) {
+	return f.GetTelemetryResponseNextPageContext(context.Background(), t)
+}
+
+func (f *Fake) GetTelemetryResponseNextPageContext(ctx context.Context, t *gosatnogs.TelemetryResponse) (*gosatnogs.TelemetryResponse, error) {
+	if t.Next == "" {
+		return nil, nil
+	}
+	return f.pageAt(ctx, t.Next)
+}
+
+func (f *Fake) GetTelemetryResponsePrevPage(t *gosatnogs.TelemetryResponse) (*gosatnogs.TelemetryResponse, error) {
+	return f.GetTelemetryResponsePrevPageContext(context.Background(), t)
+}
+
+func (f *Fake) GetTelemetryResponsePrevPageContext(ctx context.Context, t *gosatnogs.TelemetryResponse) (*gosatnogs.TelemetryResponse, error) {
+	if t.Prev == "" {
+		return nil, nil
+	}
+	return f.pageAt(ctx, t.Prev)
+}
+
+// pageAt serves a page from a link produced by pageURL.
+func (f *Fake) pageAt(ctx context.Context, link string) (*gosatnogs.TelemetryResponse, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return nil, fmt.Errorf("satnogstest: invalid page link %q", link)
+	}
+	return f.page(ctx, u.Query().Get("sat_id"), n)
+}
+
+func (f *Fake) page(ctx context.Context, satelliteID string, n int) (*gosatnogs.TelemetryResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if err, ok := f.failures[f.calls]; ok {
+		return nil, err
+	}
+
+	size := f.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	records := f.telemetry[satelliteID]
+	start := min(n*size, len(records))
+	end := min(start+size, len(records))
+	page := &gosatnogs.TelemetryResponse{
+		Results: append([]gosatnogs.Telemetry(nil), records[start:end]...),
+	}
+	if end < len(records) {
+		page.Next = pageURL(satelliteID, n+1)
+	}
+	if n > 0 {
+		page.Prev = pageURL(satelliteID, n-1)
+	}
+	return page, nil
+}
+
+func pageURL(satelliteID string, n int) string {
+	q := url.Values{"sat_id": {satelliteID}, "page": {strconv.Itoa(n)}}
+	return "fake://satnogstest/telemetry/?" + q.Encode()
+}
@@ -0,0 +1,135 @@
+package satnogstest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gosatnogs "github.com/Alatec/go-satnogs"
+)
+
+func records(satelliteID string, n int) []gosatnogs.Telemetry {
+	out := make([]gosatnogs.Telemetry, n)
+	for i := range out {
+		out[i] = gosatnogs.Telemetry{SatID: satelliteID, ObservationID: i + 1}
+	}
+	return out
+}
+
+func observationIDs(records []gosatnogs.Telemetry) []int {
+	ids := make([]int, len(records))
+	for i, r := range records {
+		ids[i] = r.ObservationID
+	}
+	return ids
+}
+
+func TestFakeCannedPages(t *testing.T) {
+	f := NewFake()
+	f.PageSize = 2
+	f.SetTelemetry("AAAA-0000", records("AAAA-0000", 5))
+
+	got, err := f.GetTelemetry("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := fmt.Sprint(observationIDs(got)); ids != "[1 2]" {
+		t.Errorf("first page = %s, want [1 2]", ids)
+	}
+
+	empty, err := f.GetTelemetryResponse("BBBB-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(empty.Results) != 0 || empty.Next != "" || empty.Prev != "" {
+		t.Errorf("unknown satellite = %+v, want an empty page", empty)
+	}
+}
+
+func TestFakeDefaultPageSize(t *testing.T) {
+	f := NewFake()
+	f.SetTelemetry("AAAA-0000", records("AAAA-0000", DefaultPageSize+1))
+
+	page, err := f.GetTelemetryResponse("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Results) != DefaultPageSize || page.Next == "" {
+		t.Errorf("got %d records, Next %q; want %d and a next page", len(page.Results), page.Next, DefaultPageSize)
+	}
+}
+
+func TestFakeWalksNextAndPrev(t *testing.T) {
+	f := NewFake()
+	f.PageSize = 2
+	f.SetTelemetry("AAAA-0000", records("AAAA-0000", 5))
+
+	var pages []string
+	page, err := f.GetTelemetryResponse("AAAA-0000")
+	for err == nil && page != nil {
+		pages = append(pages, fmt.Sprint(observationIDs(page.Results)))
+		if page.Next == "" {
+			break
+		}
+		page, err = f.GetTelemetryResponseNextPage(page)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(pages), "[[1 2] [3 4] [5]]"; got != want {
+		t.Errorf("pages = %s, want %s", got, want)
+	}
+
+	prev, err := f.GetTelemetryResponsePrevPage(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := fmt.Sprint(observationIDs(prev.Results)); ids != "[3 4]" {
+		t.Errorf("prev page = %s, want [3 4]", ids)
+	}
+	first, err := f.GetTelemetryResponsePrevPage(prev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Prev != "" {
+		t.Errorf("first page Prev = %q, want none", first.Prev)
+	}
+	if none, err := f.GetTelemetryResponsePrevPage(first); none != nil || err != nil {
+		t.Errorf("before the first page = %v, %v; want nil, nil", none, err)
+	}
+	if n := f.Calls(); n != 5 {
+		t.Errorf("Calls = %d, want 5", n)
+	}
+}
+
+func TestFakeFailOnCall(t *testing.T) {
+	f := NewFake()
+	f.PageSize = 1
+	f.SetTelemetry("AAAA-0000", records("AAAA-0000", 3))
+	boom := errors.New("boom")
+	f.FailOnCall(2, boom)
+
+	page, err := f.GetTelemetryResponse("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.GetTelemetryResponseNextPage(page); !errors.Is(err, boom) {
+		t.Errorf("second call err = %v, want the injected failure", err)
+	}
+	if next, err := f.GetTelemetryResponseNextPage(page); err != nil || next.Results[0].ObservationID != 2 {
+		t.Errorf("third call = %v, %v; want page 2", next, err)
+	}
+}
+
+func TestFakeCanceledContext(t *testing.T) {
+	f := NewFake()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := f.GetTelemetryContext(ctx, "AAAA-0000"); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if n := f.Calls(); n != 0 {
+		t.Errorf("Calls = %d, want canceled calls not counted", n)
+	}
+}
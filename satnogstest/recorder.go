@@ -0,0 +1,155 @@
+package satnogstest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RecordMode selects whether a Recorder talks to the network.
+type RecordMode int
+
+const (
+	// ModeReplay serves responses from fixtures and fails requests that have
+	// none.
+	ModeReplay RecordMode = iota
+	// ModeRecord forwards requests to the real transport and writes every
+	// exchange to a fixture file.
+	ModeRecord
+)
+
+// Recorder is an http.RoundTripper that records API exchanges to fixture
+// files and replays them later, so integration tests can run without network
+// access. Plug it in with gosatnogs.WithHTTPClient(&http.Client{Transport: r}).
+//
+// Fixtures are keyed by method and URL. Requests for the same URL are
+// recorded and replayed in sequence, so a URL requested twice gets two
+// fixtures. The Authorization header is never written to disk.
+type Recorder struct {
+	dir  string
+	mode RecordMode
+
+	// Transport is used to reach the network in ModeRecord. Nil means
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mu   sync.Mutex
+	seen map[string]int
+}
+
+// fixture is the on-disk form of one exchange.
+type fixture struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody []byte      `json:"request_body,omitempty"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header"`
+	// Body is stored base64-encoded so compressed bodies survive intact.
+	Body []byte `json:"body"`
+}
+
+// NewRecorder returns a Recorder keeping its fixtures in dir, which is created
+// in ModeRecord.
+func NewRecorder(dir string, mode RecordMode) (*Recorder, error) {
+	if mode == ModeRecord {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return &Recorder{dir: dir, mode: mode, seen: make(map[string]int)}, nil
+}
+
+// path returns the fixture file for the next occurrence of req.
+func (r *Recorder) path(req *http.Request) string {
+	key := req.Method + " " + req.URL.String()
+	r.mu.Lock()
+	n := r.seen[key]
+	r.seen[key] = n + 1
+	r.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(r.dir, fmt.Sprintf("%s-%d.json", hex.EncodeToString(sum[:8]), n))
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := r.path(req)
+	if r.mode == ModeReplay {
+		return r.replay(req, path)
+	}
+	return r.record(req, path)
+}
+
+func (r *Recorder) replay(req *http.Request, path string) (*http.Response, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("satnogstest: no fixture for %s %s: %w", req.Method, req.URL, err)
+	}
+	var f fixture
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return nil, fmt.Errorf("satnogstest: reading fixture %s: %w", path, err)
+	}
+	if f.Method != req.Method || f.URL != req.URL.String() {
+		return nil, fmt.Errorf("satnogstest: fixture %s is for %s %s, not %s %s", path, f.Method, f.URL, req.Method, req.URL)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        f.Header,
+		Body:          io.NopCloser(bytes.NewReader(f.Body)),
+		ContentLength: int64(len(f.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request, path string) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	raw, err := json.MarshalIndent(fixture{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		RequestBody: reqBody,
+		StatusCode:  resp.StatusCode,
+		Header:      resp.Header,
+		Body:        body,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return nil, fmt.Errorf("satnogstest: writing fixture: %w", err)
+	}
+	return resp, nil
+}
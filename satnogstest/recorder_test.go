@@ -0,0 +1,100 @@
+package satnogstest
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	gosatnogs "github.com/Alatec/go-satnogs"
+)
+
+const secretKey = "secret-api-key"
+
+func recorderClient(t *testing.T, r *Recorder, baseURL string) *gosatnogs.Client {
+	t.Helper()
+	c, err := gosatnogs.New(secretKey,
+		gosatnogs.WithBaseURL(baseURL),
+		gosatnogs.WithHTTPClient(&http.Client{Transport: r}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestRecorderRecordThenReplay(t *testing.T) {
+	var served atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token "+secretKey {
+			http.Error(w, "no credentials", http.StatusUnauthorized)
+			return
+		}
+		n := served.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count":1,"results":[{"sat_id":"AAAA-0000","observation_id":` + strconv.Itoa(int(n)) + `}]}`))
+	}))
+	dir := filepath.Join(t.TempDir(), "fixtures")
+
+	rec, err := NewRecorder(dir, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := recorderClient(t, rec, srv.URL)
+	for want := 1; want <= 2; want++ {
+		got, err := c.GetTelemetry("AAAA-0000")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got[0].ObservationID != want {
+			t.Fatalf("recording call %d got observation %d", want, got[0].ObservationID)
+		}
+	}
+	srv.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("recorded %d fixtures, want 2", len(files))
+	}
+	for _, name := range files {
+		raw, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(raw, []byte(secretKey)) || bytes.Contains(raw, []byte("Authorization")) {
+			t.Errorf("fixture %s contains the credentials:\n%s", name, raw)
+		}
+	}
+
+	// The server is gone; replay serves both exchanges in order.
+	rep, err := NewRecorder(dir, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = recorderClient(t, rep, srv.URL)
+	for want := 1; want <= 2; want++ {
+		got, err := c.GetTelemetry("AAAA-0000")
+		if err != nil {
+			t.Fatalf("replaying call %d: %v", want, err)
+		}
+		if got[0].ObservationID != want {
+			t.Errorf("replaying call %d got observation %d", want, got[0].ObservationID)
+		}
+	}
+
+	_, err = c.GetTelemetry("AAAA-0000")
+	if err == nil || !strings.Contains(err.Error(), "no fixture") {
+		t.Errorf("third replay err = %v, want a missing fixture", err)
+	}
+	if _, err := c.GetTelemetry("BBBB-0000"); err == nil || !strings.Contains(err.Error(), "no fixture") {
+		t.Errorf("unrecorded URL err = %v, want a missing fixture", err)
+	}
+}
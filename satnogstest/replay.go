@@ -0,0 +1,127 @@
+package satnogstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+
+	gosatnogs "github.com/Alatec/go-satnogs"
+)
+
+// Fixture is an http.RoundTripper that answers telemetry requests from JSON
+// files in a file system, so a real gosatnogs.Client can run offline and
+// deterministically. Each file is named after a satellite ID, e.g.
+// "XXXX-0000-0000-0000-0000.json", and holds a JSON array of telemetry
+// records, newest first like the real API. Records are served in pages of
+// PageSize linked by Next and Prev. Satellites without a file get an empty
+// page; any other endpoint answers 404.
+type Fixture struct {
+	// PageSize is the number of records per page. Zero means DefaultPageSize.
+	PageSize int
+
+	fsys fs.FS
+}
+
+// NewFixture returns a Fixture serving telemetry from fsys.
+func NewFixture(fsys fs.FS) *Fixture {
+	return &Fixture{fsys: fsys}
+}
+
+// NewReplayClient returns a client whose telemetry requests are served by a
+// Fixture reading fsys instead of the network. opts are applied after the
+// fixture transport is installed, so a later WithHTTPClient replaces it.
+func NewReplayClient(fsys fs.FS, opts ...gosatnogs.Option) (*gosatnogs.Client, error) {
+	opts = append([]gosatnogs.Option{
+		gosatnogs.WithHTTPClient(&http.Client{Transport: NewFixture(fsys)}),
+	}, opts...)
+	return gosatnogs.New("", opts...)
+}
+
+func (f *Fixture) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	if !strings.HasSuffix(req.URL.Path, "/telemetry/") {
+		return jsonResponse(req, http.StatusNotFound, map[string]string{"detail": "Not found."})
+	}
+
+	q := req.URL.Query()
+	satelliteID := q.Get("sat_id")
+	records, err := f.records(satelliteID)
+	if err != nil {
+		return nil, err
+	}
+	n := 1
+	if p := q.Get("page"); p != "" {
+		if n, err = strconv.Atoi(p); err != nil || n < 1 {
+			return jsonResponse(req, http.StatusNotFound, map[string]string{"detail": "Invalid page."})
+		}
+	}
+
+	size := f.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	start := min((n-1)*size, len(records))
+	end := min(start+size, len(records))
+	page := gosatnogs.TelemetryResponse{
+		Count:   len(records),
+		Results: records[start:end],
+	}
+	link := func(n int) string {
+		u := *req.URL
+		q := u.Query()
+		q.Set("page", strconv.Itoa(n))
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+	if end < len(records) {
+		page.Next = link(n + 1)
+	}
+	if n > 1 {
+		page.Prev = link(n - 1)
+	}
+	return jsonResponse(req, http.StatusOK, page)
+}
+
+// records loads the fixture for satelliteID.
+func (f *Fixture) records(satelliteID string) ([]gosatnogs.Telemetry, error) {
+	if satelliteID == "" || !fs.ValidPath(satelliteID) || strings.Contains(satelliteID, "/") {
+		return nil, nil
+	}
+	data, err := fs.ReadFile(f.fsys, satelliteID+".json")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []gosatnogs.Telemetry
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func jsonResponse(req *http.Request, status int, v any) (*http.Response, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode:    status,
+		Status:        strconv.Itoa(status) + " " + http.StatusText(status),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
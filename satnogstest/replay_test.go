@@ -0,0 +1,62 @@
+package satnogstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/fstest"
+)
+
+func TestReplayClientPages(t *testing.T) {
+	data, err := json.Marshal(records("AAAA-0000", 2*DefaultPageSize+10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewReplayClient(fstest.MapFS{"AAAA-0000.json": {Data: data}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := c.GetTelemetryResponse("AAAA-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Count != 2*DefaultPageSize+10 || page.Prev != "" {
+		t.Errorf("first page Count = %d, Prev = %q", page.Count, page.Prev)
+	}
+	var sizes []int
+	next := 1
+	for {
+		sizes = append(sizes, len(page.Results))
+		for _, r := range page.Results {
+			if r.ObservationID != next {
+				t.Fatalf("got observation %d, want %d", r.ObservationID, next)
+			}
+			next++
+		}
+		following, err := c.GetTelemetryResponseNextPage(page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if following == nil {
+			break
+		}
+		page = following
+	}
+	if got, want := fmt.Sprint(sizes), fmt.Sprint([]int{DefaultPageSize, DefaultPageSize, 10}); got != want {
+		t.Errorf("page sizes = %s, want %s", got, want)
+	}
+
+	prev, err := c.GetTelemetryResponsePrevPage(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prev.Results[0].ObservationID != DefaultPageSize+1 {
+		t.Errorf("Prev served observation %d first, want %d", prev.Results[0].ObservationID, DefaultPageSize+1)
+	}
+
+	empty, err := c.GetTelemetry("BBBB-0000")
+	if err != nil || len(empty) != 0 {
+		t.Errorf("satellite without a fixture = %v, %v; want an empty page", empty, err)
+	}
+}
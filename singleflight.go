@@ -0,0 +1,72 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// WithSingleflight makes concurrent identical requests share one round trip.
+// While a request for a URL is in flight, further callers asking for the same
+// URL with the same API key wait for it instead of sending their own, and all
+// of them decode the same response or receive the same error. Nothing is kept
+// once the round trip completes; use WithCache for that. Only the GET
+// requests behind the typed methods are shared; Get, GetWithContext and
+// downloads always send their own request, as do calls whose context carries
+// request options or comes from BypassCache, since the shared request would
+// not honour them.
+//
+// A waiter stops waiting when its own context is done. The shared request
+// runs under the context of the caller that started it, so that caller
+// cancelling it fails every waiter.
+func WithSingleflight() Option {
+	return func(c *Client) error {
+		c.flights = new(singleflight.Group)
+		return nil
+	}
+}
+
+// sharedBody is the outcome of a shared request that got a response.
+type sharedBody struct {
+	data []byte
+	err  error
+}
+
+// shareable reports whether a request made with ctx may join or lead a shared
+// round trip: one that is sent with the caller's own headers, timeout or cache
+// policy may not.
+func shareable(ctx context.Context) bool {
+	return ctx.Value(requestOptionsKey{}) == nil && !cacheBypassed(ctx)
+}
+
+// getSharedJSON is fetchJSON for a client with single-flight enabled.
+func (c *Client) getSharedJSON(ctx context.Context, rawURL string, out any) (received bool, err error) {
+	var authorization string
+	if k := c.key(); k != "" {
+		authorization = "Token " + k
+	}
+	ch := c.flights.DoChan(c.cacheKeyFor(authorization, rawURL), func() (any, error) {
+		resp, err := c.getURL(ctx, rawURL)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		return sharedBody{data, err}, nil
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return false, res.Err
+		}
+		body := res.Val.(sharedBody)
+		if body.err != nil {
+			return true, body.err
+		}
+		return true, c.decodeJSON(bytes.NewReader(body.data), rawURL, out)
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
@@ -0,0 +1,137 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSingleflightSharesRoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{"success", http.StatusOK, nil},
+		{"error", http.StatusServiceUnavailable, ErrServer},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int32
+			release := make(chan struct{})
+			c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				hits.Add(1)
+				<-release
+				if tt.status != http.StatusOK {
+					http.Error(w, "down", tt.status)
+					return
+				}
+				writeJSON(w, fullPage)
+			}, WithSingleflight())
+
+			const callers = 10
+			errs := make(chan error, callers)
+			var wg sync.WaitGroup
+			for range callers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					got, err := c.GetTelemetry("AAAA-0000")
+					if err == nil && (len(got) != 1 || got[0].SatID != "AAAA-0000") {
+						t.Errorf("GetTelemetry = %+v, want the shared page", got)
+					}
+					errs <- err
+				}()
+			}
+			// Let every caller join the request in flight.
+			time.Sleep(50 * time.Millisecond)
+			close(release)
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				if !errors.Is(err, tt.want) {
+					t.Errorf("err = %v, want %v", err, tt.want)
+				}
+			}
+			if n := hits.Load(); n != 1 {
+				t.Errorf("server saw %d requests, want 1", n)
+			}
+		})
+	}
+}
+
+func TestSingleflightDistinctURLs(t *testing.T) {
+	var hits atomic.Int32
+	release := make(chan struct{})
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		<-release
+		writeJSON(w, fullPage)
+	}, WithSingleflight())
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"AAAA-0000", "BBBB-1111", "CCCC-2222"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetTelemetry(id); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := hits.Load(); n != 3 {
+		t.Errorf("server saw %d requests, want one per satellite", n)
+	}
+}
+
+func TestSingleflightSkipsPerCallOptions(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		ids []string
+	)
+	release := make(chan struct{})
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		ids = append(ids, r.Header.Get("X-Request-ID"))
+		mu.Unlock()
+		<-release
+		writeJSON(w, fullPage)
+	}, WithSingleflight())
+
+	ctxs := map[string]context.Context{
+		"plain":        context.Background(),
+		"header":       WithRequestOptions(context.Background(), WithRequestHeader("X-Request-ID", "own")),
+		"call timeout": WithRequestOptions(context.Background(), WithCallTimeout(time.Minute)),
+		"bypass cache": BypassCache(context.Background()),
+	}
+	var wg sync.WaitGroup
+	for name, ctx := range ctxs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetTelemetryContext(ctx, "AAAA-0000"); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(ids) != len(ctxs) {
+		t.Errorf("server saw %d requests, want one per caller", len(ids))
+	}
+	if !slices.Contains(ids, "own") {
+		t.Errorf("X-Request-ID values %q, want the per-call header sent", ids)
+	}
+}
@@ -0,0 +1,97 @@
+package gosatnogs
+
+import (
+	"context"
+	"time"
+)
+
+// Ground station status values reported by the SatNOGS Network API.
+const (
+	StationStatusOnline  = "Online"
+	StationStatusTesting = "Testing"
+	StationStatusOffline = "Offline"
+)
+
+// Station is a SatNOGS network ground station. Stations are served by the
+// Network API, so they must be requested with a client created with
+// WithBaseURL(NetworkBaseURL).
+type Station struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Lat        float64    `json:"lat"`
+	Lng        float64    `json:"lng"`
+	Altitude   float64    `json:"altitude"`
+	Status     string     `json:"status"`
+	QthLocator string     `json:"qthlocator"`
+	LastSeen   *time.Time `json:"last_seen"`
+}
+
+// StationResponse is a page of ground stations.
+type StationResponse = Page[Station]
+
+// StationFilter narrows the stations returned by GetStations. Zero values are
+// not sent to the API.
+type StationFilter struct {
+	ID int
+	// Status is one of the StationStatus constants.
+	Status string
+}
+
+func (f StationFilter) params() Params {
+	params := Params{"format": {"json"}}
+	if f.ID != 0 {
+		params.SetInt("id", f.ID)
+	}
+	if f.Status != "" {
+		params.Set("status", f.Status)
+	}
+	return params
+}
+
+// GetStations retrieves the first page of ground stations matching filter
+// from the Network API. Use GetStationResponse to follow pagination.
+func (c *Client) GetStations(filter StationFilter) ([]Station, error) {
+	return c.GetStationsContext(context.Background(), filter)
+}
+
+// GetStationsContext is like GetStations but binds the request to ctx.
+func (c *Client) GetStationsContext(ctx context.Context, filter StationFilter) ([]Station, error) {
+	resp, err := c.GetStationResponseContext(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *Client) GetStationResponse(filter StationFilter) (*StationResponse, error) {
+	return c.GetStationResponseContext(context.Background(), filter)
+}
+
+// GetStationResponseContext is like GetStationResponse but binds the request to ctx.
+func (c *Client) GetStationResponseContext(ctx context.Context, filter StationFilter) (*StationResponse, error) {
+	var stationResponse StationResponse
+	if err := c.getJSON(ctx, "/stations/", filter.params(), &stationResponse); err != nil {
+		return nil, err
+	}
+	return &stationResponse, nil
+}
+
+func (c *Client) GetStationResponseNextPage(s *StationResponse) (*StationResponse, error) {
+	return c.GetStationResponseNextPageContext(context.Background(), s)
+}
+
+// GetStationResponseNextPageContext is like GetStationResponseNextPage but
+// binds the request to ctx.
+func (c *Client) GetStationResponseNextPageContext(ctx context.Context, s *StationResponse) (*StationResponse, error) {
+	return NextPage(ctx, c, s)
+}
+
+func (c *Client) GetStationResponsePrevPage(s *StationResponse) (*StationResponse, error) {
+	return c.GetStationResponsePrevPageContext(context.Background(), s)
+}
+
+// GetStationResponsePrevPageContext is like GetStationResponsePrevPage but
+// binds the request to ctx.
+func (c *Client) GetStationResponsePrevPageContext(ctx context.Context, s *StationResponse) (*StationResponse, error) {
+	return PrevPage(ctx, c, s)
+}
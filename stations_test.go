@@ -0,0 +1,36 @@
+package gosatnogs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestStationResponsePagesContext(t *testing.T) {
+	var traces []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		traces = append(traces, r.Header.Get("X-Trace"))
+		writeJSON(w, `{"count":3,"next":"http://`+r.Host+`/stations/?page=2","prev":"http://`+r.Host+`/stations/?page=1","results":[{"id":1}]}`)
+	})
+	ctx := WithRequestOptions(context.Background(), WithRequestHeader("X-Trace", "abc"))
+	page := &StationResponse{Next: srv.URL + "/stations/?page=2", Prev: srv.URL + "/stations/?page=1"}
+
+	if _, err := c.GetStationResponseNextPageContext(ctx, page); err != nil {
+		t.Fatalf("GetStationResponseNextPageContext: %v", err)
+	}
+	if _, err := c.GetStationResponsePrevPageContext(ctx, page); err != nil {
+		t.Fatalf("GetStationResponsePrevPageContext: %v", err)
+	}
+	if len(traces) != 2 || traces[0] != "abc" || traces[1] != "abc" {
+		t.Errorf("X-Trace headers = %q, want the context's on both requests", traces)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.GetStationResponseNextPageContext(cancelled, page); err == nil {
+		t.Error("GetStationResponseNextPageContext with a cancelled context succeeded")
+	}
+	if len(traces) != 2 {
+		t.Errorf("server saw %d requests, want none for the cancelled context", len(traces)-2)
+	}
+}
@@ -0,0 +1,123 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// StreamTelemetry calls fn for every telemetry record of a satellite, across
+// all pages, decoding records one at a time instead of buffering whole pages.
+// It stops and returns the error if fn returns one or ctx is cancelled.
+func (c *Client) StreamTelemetry(ctx context.Context, satelliteID string, fn func(Telemetry) error) error {
+	resp, err := c.GetWithContext(ctx, "/telemetry/", c.telemetryParams(Params{"sat_id": {satelliteID}, "format": {"json"}}))
+	for {
+		if err != nil {
+			return err
+		}
+		var requestURL string
+		if resp.Request != nil {
+			requestURL = resp.Request.URL.String()
+		}
+		var next string
+		if next, err = c.streamTelemetryPage(resp, requestURL, fn); err != nil || next == "" {
+			return err
+		}
+		next = c.rebasePageURL(next, requestURL)
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if err = c.checkPageURL(next); err != nil {
+			return err
+		}
+		resp, err = c.getURL(ctx, c.upgradeScheme(next))
+	}
+}
+
+// streamTelemetryPage decodes the results of one page, passing each record to
+// fn, and returns the page's Next link. The body is drained and closed.
+func (c *Client) streamTelemetryPage(resp *http.Response, requestURL string, fn func(Telemetry) error) (next string, err error) {
+	defer func() {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrain))
+		resp.Body.Close()
+	}()
+
+	dec := c.newDecoder(resp.Body)
+	if err := expectDelim(dec, '{'); err != nil {
+		return "", err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		key, _ := tok.(string)
+		switch key {
+		case "next":
+			var n *string
+			if err := dec.Decode(&n); err != nil {
+				return "", err
+			}
+			if n != nil {
+				next = *n
+			}
+		case "results":
+			if err := expectDelim(dec, '['); err != nil {
+				return "", err
+			}
+			for dec.More() {
+				var t Telemetry
+				if err := c.decodeRecord(dec, &t); err != nil {
+					return "", c.decodeError(requestURL, err)
+				}
+				if err := fn(t); err != nil {
+					return "", err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return "", err
+			}
+		default:
+			if c.strict && key != "count" && key != "prev" {
+				return "", c.decodeError(requestURL, fmt.Errorf("json: unknown field %q", key))
+			}
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return "", err
+			}
+		}
+	}
+	return next, expectDelim(dec, '}')
+}
+
+// decodeRecord decodes the next value of dec into t, keeping its raw JSON
+// when the client captures it.
+func (c *Client) decodeRecord(dec *json.Decoder, t *Telemetry) error {
+	if !c.rawCapture {
+		return dec.Decode(t)
+	}
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	if err := c.newDecoder(bytes.NewReader(raw)).Decode(t); err != nil {
+		return err
+	}
+	t.Raw = raw
+	return nil
+}
+
+// expectDelim reads the next token from dec and checks that it is want.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("satnogs: unexpected JSON token %v, want %v", tok, want)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package gosatnogs
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestStreamTelemetryPageSize(t *testing.T) {
+	for _, tt := range []struct {
+		pageSize  int
+		wantPages int32
+	}{
+		{0, 5},
+		{5, 2},
+		{10, 1},
+	} {
+		var hits atomic.Int32
+		serve := pagedTelemetry(10)
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			serve(w, r)
+		}, WithPageSize(tt.pageSize))
+
+		var records int
+		err := c.StreamTelemetry(context.Background(), "AAAA-0000", func(Telemetry) error {
+			records++
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("page size %d: %v", tt.pageSize, err)
+		}
+		if records != 10 {
+			t.Errorf("page size %d: streamed %d records, want 10", tt.pageSize, records)
+		}
+		if n := hits.Load(); n != tt.wantPages {
+			t.Errorf("page size %d: fetched %d pages, want %d", tt.pageSize, n, tt.wantPages)
+		}
+	}
+}
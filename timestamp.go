@@ -0,0 +1,52 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the layouts Timestamp accepts, tried in order.
+// RFC3339Nano also matches RFC 3339 timestamps without fractional seconds.
+// The others have been seen in SatNOGS DB responses; those without a zone
+// are taken as UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Timestamp is a time.Time that decodes from the timestamp layouts the
+// SatNOGS DB API is known to emit, not only RFC 3339. A JSON null leaves it
+// zero. The methods of time.Time are available on it directly.
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("satnogs: timestamp %s is not a string", data)
+	}
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("satnogs: unsupported timestamp %q", s)
+}
+
+// MarshalJSON encodes t in RFC 3339 with fractional seconds, or as null when
+// t is zero.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
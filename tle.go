@@ -0,0 +1,71 @@
+package gosatnogs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TLE is a two-line element set for a satellite. TleLine0 holds the satellite
+// name line; TleLine1 and TleLine2 are the element lines to feed into SGP4.
+type TLE struct {
+	TleLine0   string    `json:"tle0"`
+	TleLine1   string    `json:"tle1"`
+	TleLine2   string    `json:"tle2"`
+	TleSource  string    `json:"tle_source"`
+	SatID      string    `json:"sat_id"`
+	NoradCatID int       `json:"norad_cat_id"`
+	UpdatedAt  time.Time `json:"updated"`
+
+	// ETag is the entity tag of the response the TLE came from, or "" if
+	// the server sent none. Pass it to GetTLEIfChanged to poll cheaply.
+	ETag string `json:"-"`
+}
+
+// GetTLE retrieves the latest TLE set for the satellite with the given NORAD
+// catalog ID. If the DB has no elements for it, the error matches ErrNotFound.
+func (c *Client) GetTLE(noradID int) (*TLE, error) {
+	return c.GetTLEContext(context.Background(), noradID)
+}
+
+// GetTLEContext is like GetTLE but binds the request to ctx.
+func (c *Client) GetTLEContext(ctx context.Context, noradID int) (*TLE, error) {
+	rawURL, err := c.endpointURL("/tle/", Params{"norad_cat_id": {strconv.Itoa(noradID)}, "format": {"json"}})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.getURL(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	etag := resp.Header.Get("ETag")
+	var tles []TLE
+	if err := c.decodeBody(resp, rawURL, &tles); err != nil {
+		return nil, err
+	}
+	if len(tles) == 0 {
+		return nil, fmt.Errorf("satnogs: no TLE for NORAD ID %d: %w", noradID, ErrNotFound)
+	}
+	latest := &tles[0]
+	for i := range tles[1:] {
+		if tles[i+1].UpdatedAt.After(latest.UpdatedAt) {
+			latest = &tles[i+1]
+		}
+	}
+	latest.ETag = etag
+	return latest, nil
+}
+
+// GetTLEIfChanged is like GetTLEContext but sends etag, typically the ETag of
+// a TLE fetched earlier, in an If-None-Match header. If the elements have not
+// changed since, the server answers 304 Not Modified and the error matches
+// ErrNotModified, so a poller can skip reprocessing. An empty etag makes it
+// an unconditional GetTLEContext. The client's response cache is bypassed.
+func (c *Client) GetTLEIfChanged(ctx context.Context, noradID int, etag string) (*TLE, error) {
+	ctx = BypassCache(ctx)
+	if etag != "" {
+		ctx = WithRequestOptions(ctx, WithRequestHeader("If-None-Match", etag))
+	}
+	return c.GetTLEContext(ctx, noradID)
+}
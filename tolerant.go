@@ -0,0 +1,75 @@
+package gosatnogs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// RecordError reports a result of a page that could not be decoded by a
+// client created with WithTolerantDecoding.
+type RecordError struct {
+	// Index is the position of the record in the page's results.
+	Index int
+	// Raw is the JSON of the record.
+	Raw json.RawMessage
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("satnogs: result %d: %v", e.Index, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// WithTolerantDecoding makes the client decode the results of a page one by
+// one, so that a record that fails to decode is skipped instead of failing
+// the whole page. The failures are reported in the Errors field of the page,
+// and the call itself succeeds. This suits bulk ingestion, which would rather
+// keep 999 good frames and log one bad one than lose them all. A body that is
+// not a page at all still fails the call.
+func WithTolerantDecoding() Option {
+	return func(c *Client) error {
+		c.tolerant = true
+		return nil
+	}
+}
+
+// tolerantDecoder is implemented by values that can be decoded record by
+// record.
+type tolerantDecoder interface {
+	decodeTolerant(c *Client, data []byte, rawURL string) error
+}
+
+// decodeTolerant decodes data into p, collecting the results that fail to
+// decode in p.Errors.
+func (p *Page[T]) decodeTolerant(c *Client, data []byte, rawURL string) error {
+	var page struct {
+		Count   int               `json:"count"`
+		Next    string            `json:"next"`
+		Prev    string            `json:"prev"`
+		Results []json.RawMessage `json:"results"`
+	}
+	if err := c.newDecoder(bytes.NewReader(data)).Decode(&page); err != nil {
+		return c.decodeError(rawURL, err)
+	}
+	p.Count, p.Next, p.Prev = page.Count, page.Next, page.Prev
+	p.Results = make([]T, 0, len(page.Results))
+	if c.rawCapture {
+		p.Raw = data
+	}
+	for i, raw := range page.Results {
+		var v T
+		if err := c.newDecoder(bytes.NewReader(raw)).Decode(&v); err != nil {
+			p.Errors = append(p.Errors, &RecordError{Index: i, Raw: raw, Err: c.decodeError(rawURL, err)})
+			continue
+		}
+		if rs, ok := any(&v).(rawSetter); ok && c.rawCapture {
+			rs.setRaw(raw)
+		}
+		p.Results = append(p.Results, v)
+	}
+	return nil
+}
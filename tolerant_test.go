@@ -0,0 +1,51 @@
+package gosatnogs
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTolerantDecodingSkipsMalformedRecord(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/telemetry_malformed_record.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) { writeJSON(w, string(fixture)) }
+
+	strict, _ := newTestClient(t, handler)
+	if _, err := strict.GetTelemetryResponse("XUSN-0095-5010-0047-8935"); err == nil {
+		t.Error("default decoding accepted the malformed record")
+	}
+
+	c, _ := newTestClient(t, handler, WithTolerantDecoding())
+	page, err := c.GetTelemetryResponse("XUSN-0095-5010-0047-8935")
+	if err != nil {
+		t.Fatalf("tolerant GetTelemetryResponse: %v", err)
+	}
+	if page.Count != 3 {
+		t.Errorf("Count = %d, want 3", page.Count)
+	}
+	var frames []string
+	for _, r := range page.Results {
+		frames = append(frames, r.Frame[len(r.Frame)-2:])
+	}
+	if got := strings.Join(frames, " "); got != "F0 F2" {
+		t.Errorf("decoded frames ending %s, want F0 F2", got)
+	}
+
+	if len(page.Errors) != 1 {
+		t.Fatalf("Errors = %v, want the one malformed record", page.Errors)
+	}
+	recErr := page.Errors[0]
+	if recErr.Index != 1 || !strings.Contains(string(recErr.Raw), `"norad_cat_id": "ISS"`) {
+		t.Errorf("RecordError = index %d, raw %s", recErr.Index, recErr.Raw)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(recErr, &typeErr) {
+		t.Errorf("RecordError %v does not wrap the decoding error", recErr)
+	}
+}
@@ -0,0 +1,89 @@
+package gosatnogs
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Tracer starts spans for the client's logical operations, such as fetching
+// a satellite's telemetry or one page of it. It lets a tracing system like
+// OpenTelemetry (see the otelsatnogs package) observe the client without this
+// package depending on it. Implementations must be safe for concurrent use.
+type Tracer interface {
+	// Start begins a span for operation, e.g. "GetTelemetry", as a child of
+	// any span in ctx, and returns a context carrying the new span.
+	Start(ctx context.Context, operation string) (context.Context, Span)
+}
+
+// Span is one operation in progress.
+type Span interface {
+	// SetAttribute records a property of the operation. value is a string,
+	// an int or a bool.
+	SetAttribute(key string, value any)
+	// End finishes the span, recording err if the operation failed.
+	End(err error)
+}
+
+// Attribute keys the client sets on spans.
+const (
+	AttrSatelliteID = "satnogs.satellite_id"
+	AttrEndpoint    = "satnogs.endpoint"
+	AttrPage        = "satnogs.page"
+	AttrResultCount = "satnogs.result_count"
+	AttrHTTPStatus  = "http.response.status_code"
+)
+
+// WithTracer makes the client report its operations to t. Without this option
+// no spans are created and tracing costs nothing.
+func WithTracer(t Tracer) Option {
+	return func(c *Client) error {
+		c.tracer = t
+		return nil
+	}
+}
+
+type spanKey struct{}
+
+// noopSpan is used when no tracer is configured.
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, any) {}
+func (noopSpan) End(error)                {}
+
+// startSpan starts a span for operation when a tracer is configured. The
+// span is stored in the returned context so that round trips can record
+// their status on it.
+func (c *Client) startSpan(ctx context.Context, operation string) (context.Context, Span) {
+	if c.tracer == nil {
+		return ctx, noopSpan{}
+	}
+	ctx, span := c.tracer.Start(ctx, operation)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// spanFrom returns the innermost span started by the client in ctx, if any.
+func spanFrom(ctx context.Context) (Span, bool) {
+	span, ok := ctx.Value(spanKey{}).(Span)
+	return span, ok
+}
+
+// setURLAttributes records the endpoint, page number and satellite ID of a
+// request for rawURL on span.
+func (c *Client) setURLAttributes(span Span, rawURL string) {
+	if c.tracer == nil {
+		return
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return
+	}
+	span.SetAttribute(AttrEndpoint, c.endpoint(u))
+	q := u.Query()
+	if n, err := strconv.Atoi(q.Get("page")); err == nil {
+		span.SetAttribute(AttrPage, n)
+	}
+	if id := q.Get("sat_id"); id != "" {
+		span.SetAttribute(AttrSatelliteID, id)
+	}
+}
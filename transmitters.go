@@ -0,0 +1,84 @@
+package gosatnogs
+
+import (
+	"context"
+	"time"
+)
+
+// Transmitter describes a radio transmitter (or transceiver/transponder) on a
+// satellite. Frequencies are in Hz; nil means the value is not applicable or
+// unknown. UUID matches the Transmitter field of Telemetry.
+type Transmitter struct {
+	UUID          string    `json:"uuid"`
+	Description   string    `json:"description"`
+	Alive         bool      `json:"alive"`
+	Type          string    `json:"type"`
+	UplinkLow     *int64    `json:"uplink_low"`
+	UplinkHigh    *int64    `json:"uplink_high"`
+	UplinkDrift   *int      `json:"uplink_drift"`
+	DownlinkLow   *int64    `json:"downlink_low"`
+	DownlinkHigh  *int64    `json:"downlink_high"`
+	DownlinkDrift *int      `json:"downlink_drift"`
+	Mode          string    `json:"mode"`
+	ModeID        *int      `json:"mode_id"`
+	UplinkMode    string    `json:"uplink_mode"`
+	Invert        bool      `json:"invert"`
+	Baud          *float64  `json:"baud"`
+	SatID         string    `json:"sat_id"`
+	NoradCatID    int       `json:"norad_cat_id"`
+	Status        string    `json:"status"`
+	Service       string    `json:"service"`
+	Updated       time.Time `json:"updated"`
+}
+
+// TransmitterResponse is a page of transmitters.
+type TransmitterResponse = Page[Transmitter]
+
+// GetTransmitters retrieves the first page of transmitters for a satellite.
+// Use GetTransmitterResponse to follow pagination. A satellite without
+// transmitters yields an empty slice and a nil error.
+func (c *Client) GetTransmitters(satID string) ([]Transmitter, error) {
+	return c.GetTransmittersContext(context.Background(), satID)
+}
+
+// GetTransmittersContext is like GetTransmitters but binds the request to ctx.
+func (c *Client) GetTransmittersContext(ctx context.Context, satID string) ([]Transmitter, error) {
+	resp, err := c.GetTransmitterResponseContext(ctx, satID)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *Client) GetTransmitterResponse(satID string) (*TransmitterResponse, error) {
+	return c.GetTransmitterResponseContext(context.Background(), satID)
+}
+
+// GetTransmitterResponseContext is like GetTransmitterResponse but binds the request to ctx.
+func (c *Client) GetTransmitterResponseContext(ctx context.Context, satID string) (*TransmitterResponse, error) {
+	var transmitterResponse TransmitterResponse
+	if err := c.getJSON(ctx, "/transmitters/", Params{"sat_id": {satID}, "format": {"json"}}, &transmitterResponse); err != nil {
+		return nil, err
+	}
+	return &transmitterResponse, nil
+}
+
+func (c *Client) GetTransmitterResponseNextPage(t *TransmitterResponse) (*TransmitterResponse, error) {
+	return c.GetTransmitterResponseNextPageContext(context.Background(), t)
+}
+
+// GetTransmitterResponseNextPageContext is like GetTransmitterResponseNextPage but
+// binds the request to ctx.
+func (c *Client) GetTransmitterResponseNextPageContext(ctx context.Context, t *TransmitterResponse) (*TransmitterResponse, error) {
+	return NextPage(ctx, c, t)
+}
+
+func (c *Client) GetTransmitterResponsePrevPage(t *TransmitterResponse) (*TransmitterResponse, error) {
+	return c.GetTransmitterResponsePrevPageContext(context.Background(), t)
+}
+
+// GetTransmitterResponsePrevPageContext is like GetTransmitterResponsePrevPage but
+// binds the request to ctx.
+func (c *Client) GetTransmitterResponsePrevPageContext(ctx context.Context, t *TransmitterResponse) (*TransmitterResponse, error) {
+	return PrevPage(ctx, c, t)
+}
@@ -0,0 +1,217 @@
+package gosatnogs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTLSConfigAndHTTPClientOrder(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, fullPage)
+	}))
+	defer srv.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	trusting := &tls.Config{RootCAs: pool}
+
+	newHTTPClient := func() (*http.Client, *http.Transport) {
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		return &http.Client{Transport: tr}, tr
+	}
+
+	t.Run("TLS config alone", func(t *testing.T) {
+		c, err := New("", WithBaseURL(srv.URL), WithTLSConfig(trusting))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+			t.Fatalf("GetTelemetry: %v", err)
+		}
+		if cfg := http.DefaultTransport.(*http.Transport).TLSClientConfig; cfg != nil && cfg.RootCAs != nil {
+			t.Error("WithTLSConfig modified http.DefaultTransport")
+		}
+	})
+
+	t.Run("TLS config after client", func(t *testing.T) {
+		hc, tr := newHTTPClient()
+		c, err := New("", WithBaseURL(srv.URL), WithHTTPClient(hc), WithTLSConfig(trusting))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+			t.Fatalf("GetTelemetry: %v", err)
+		}
+		if hc.Transport != tr || (tr.TLSClientConfig != nil && tr.TLSClientConfig.RootCAs != nil) {
+			t.Error("WithTLSConfig modified the caller's client or transport")
+		}
+	})
+
+	t.Run("client after TLS config", func(t *testing.T) {
+		hc, _ := newHTTPClient()
+		c, err := New("", WithBaseURL(srv.URL), WithTLSConfig(trusting), WithHTTPClient(hc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.GetTelemetry("AAAA-0000"); err == nil {
+			t.Fatal("the TLS config outlived a later WithHTTPClient")
+		}
+	})
+
+	t.Run("custom round tripper", func(t *testing.T) {
+		hc := &http.Client{Transport: RoundTripperFunc(http.DefaultTransport.RoundTrip)}
+		if _, err := New("", WithHTTPClient(hc), WithTLSConfig(trusting)); err == nil {
+			t.Fatal("WithTLSConfig accepted a transport it cannot configure")
+		}
+	})
+}
+
+func TestTransportTuning(t *testing.T) {
+	def := http.DefaultTransport.(*http.Transport)
+	before := [3]any{def.MaxIdleConnsPerHost, def.MaxConnsPerHost, def.IdleConnTimeout}
+
+	plain, _ := New("")
+	callerTransport := def.Clone()
+	callerClient := &http.Client{Transport: callerTransport}
+	for name, opts := range map[string][]Option{
+		"default transport": nil,
+		"caller's client":   {WithHTTPClient(callerClient)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts = append(opts, WithMaxIdleConnsPerHost(200), WithMaxConnsPerHost(64), WithIdleConnTimeout(time.Minute))
+			c, err := New("", opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tr, ok := c.client.Transport.(*http.Transport)
+			if !ok {
+				t.Fatalf("transport is %T, want *http.Transport", c.client.Transport)
+			}
+			if tr == def || tr == callerTransport {
+				t.Fatal("options modified a shared transport instead of a clone")
+			}
+			if tr.MaxIdleConnsPerHost != 200 || tr.MaxConnsPerHost != 64 || tr.IdleConnTimeout != time.Minute {
+				t.Errorf("transport = %d idle/host, %d conns/host, %v idle timeout", tr.MaxIdleConnsPerHost, tr.MaxConnsPerHost, tr.IdleConnTimeout)
+			}
+			if tr.MaxIdleConns < 200 {
+				t.Errorf("MaxIdleConns = %d, below the per-host limit", tr.MaxIdleConns)
+			}
+			if tr.TLSHandshakeTimeout != def.TLSHandshakeTimeout || tr.ForceAttemptHTTP2 != def.ForceAttemptHTTP2 {
+				t.Error("clone lost the defaults of the base transport")
+			}
+		})
+	}
+	if after := [3]any{def.MaxIdleConnsPerHost, def.MaxConnsPerHost, def.IdleConnTimeout}; after != before {
+		t.Errorf("http.DefaultTransport changed from %v to %v", before, after)
+	}
+	if callerTransport.MaxIdleConnsPerHost != def.MaxIdleConnsPerHost || callerClient.Transport != callerTransport {
+		t.Error("the caller's client or transport changed")
+	}
+	if plain.client.Transport != nil {
+		t.Error("a client without transport options has its own transport")
+	}
+
+	for _, opt := range []Option{WithMaxIdleConnsPerHost(-1), WithMaxConnsPerHost(-1), WithIdleConnTimeout(-time.Second)} {
+		if _, err := New("", opt); err == nil {
+			t.Error("negative transport setting accepted")
+		}
+	}
+}
+
+func BenchmarkConcurrentTelemetry(b *testing.B) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, fullPage)
+	}))
+	defer srv.Close()
+	for _, idle := range []int{2, 64} {
+		b.Run(fmt.Sprintf("idle=%d", idle), func(b *testing.B) {
+			c, err := New("", WithBaseURL(srv.URL), WithMaxIdleConnsPerHost(idle))
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer c.Close()
+			b.SetParallelism(16)
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy sees the absolute URL of the target.
+		proxied = append(proxied, r.URL.String())
+		writeJSON(w, fullPage)
+	}))
+	defer proxy.Close()
+	const base = "http://db.satnogs.invalid/api"
+
+	t.Run("URL", func(t *testing.T) {
+		proxied = nil
+		c, err := New("", WithBaseURL(base), WithProxy(proxy.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+			t.Fatalf("GetTelemetry: %v", err)
+		}
+		if len(proxied) != 1 || !strings.HasPrefix(proxied[0], base+"/telemetry/") {
+			t.Errorf("proxy saw %q, want the telemetry request", proxied)
+		}
+		if http.DefaultTransport.(*http.Transport).Proxy == nil {
+			t.Error("WithProxy modified http.DefaultTransport")
+		}
+	})
+
+	t.Run("func", func(t *testing.T) {
+		proxied = nil
+		proxyURL, _ := url.Parse(proxy.URL)
+		var asked []string
+		c, err := New("", WithBaseURL(base), WithProxyFunc(func(r *http.Request) (*url.URL, error) {
+			asked = append(asked, r.URL.Host)
+			return proxyURL, nil
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+			t.Fatalf("GetTelemetry: %v", err)
+		}
+		if len(asked) != 1 || asked[0] != "db.satnogs.invalid" || len(proxied) != 1 {
+			t.Errorf("proxy func asked for %q, proxy saw %q", asked, proxied)
+		}
+	})
+}
+
+func TestWithProxyErrors(t *testing.T) {
+	custom := RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("unreachable")
+	})
+	noProxy := func(*http.Request) (*url.URL, error) { return nil, nil }
+	for name, opts := range map[string][]Option{
+		"unsupported scheme":     {WithProxy("ftp://proxy.example:21")},
+		"missing host":           {WithProxy("http://")},
+		"malformed":              {WithProxy("://proxy")},
+		"custom transport":       {WithTransport(custom), WithProxy("http://proxy.example:3128")},
+		"custom client":          {WithHTTPClient(&http.Client{Transport: custom}), WithProxy("http://proxy.example:3128")},
+		"func, custom transport": {WithTransport(custom), WithProxyFunc(noProxy)},
+		"func, custom client":    {WithHTTPClient(&http.Client{Transport: custom}), WithProxyFunc(noProxy)},
+	} {
+		if _, err := New("", opts...); err == nil {
+			t.Errorf("%s: accepted", name)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package gosatnogs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrBudgetExceeded is returned once a client has made as many requests as
+// allowed by WithRequestBudget in the current accounting window.
+var ErrBudgetExceeded = errors.New("satnogs: request budget exceeded")
+
+// Stats are the usage counters of a client since it was created or since the
+// last ResetStats. Requests and retries count round trips to the API;
+// responses served from the cache without contacting it are not counted.
+type Stats struct {
+	// Requests counts every round trip, retries and paginated fetches
+	// included.
+	Requests int64
+	// Retries counts round trips that repeated an earlier attempt.
+	Retries int64
+	// BytesDownloaded counts response body bytes read off the wire, before
+	// gzip decoding.
+	BytesDownloaded int64
+	// ByEndpoint counts round trips per endpoint, e.g. "/telemetry/".
+	ByEndpoint map[string]int64
+}
+
+// usage holds the counters behind Client.Stats.
+type usage struct {
+	budget     int64
+	requests   atomic.Int64
+	retries    atomic.Int64
+	bytes      atomic.Int64
+	byEndpoint sync.Map // endpoint -> *atomic.Int64
+}
+
+// WithRequestBudget caps the number of round trips the client makes, retries
+// and every page fetched by auto-paginating helpers included. Once n have
+// been made, further calls fail with ErrBudgetExceeded until ResetStats
+// starts a new window. Zero, the default, means no limit.
+func WithRequestBudget(n int) Option {
+	return func(c *Client) error {
+		if n < 0 {
+			return fmt.Errorf("satnogs: invalid request budget %d", n)
+		}
+		c.usage.budget = int64(n)
+		return nil
+	}
+}
+
+// Stats returns a snapshot of the client's usage counters.
+func (c *Client) Stats() Stats {
+	s := Stats{
+		Requests:        c.usage.requests.Load(),
+		Retries:         c.usage.retries.Load(),
+		BytesDownloaded: c.usage.bytes.Load(),
+		ByEndpoint:      make(map[string]int64),
+	}
+	c.usage.byEndpoint.Range(func(k, v any) bool {
+		s.ByEndpoint[k.(string)] = v.(*atomic.Int64).Load()
+		return true
+	})
+	return s
+}
+
+// ResetStats zeroes the usage counters and starts a new request budget
+// window.
+func (c *Client) ResetStats() {
+	c.usage.requests.Store(0)
+	c.usage.retries.Store(0)
+	c.usage.bytes.Store(0)
+	c.usage.byEndpoint.Clear()
+}
+
+// acquire counts a round trip to endpoint, failing if it would exceed the
+// budget.
+func (u *usage) acquire(endpoint string, attempt int) error {
+	if n := u.requests.Add(1); u.budget > 0 && n > u.budget {
+		u.requests.Add(-1)
+		return ErrBudgetExceeded
+	}
+	if attempt > 1 {
+		u.retries.Add(1)
+	}
+	v, _ := u.byEndpoint.LoadOrStore(endpoint, new(atomic.Int64))
+	v.(*atomic.Int64).Add(1)
+	return nil
+}
+
+// countingBody adds the bytes read from a response body to a counter.
+type countingBody struct {
+	io.ReadCloser
+	n *atomic.Int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,60 @@
+package gosatnogs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRequestBudgetStopsPagination(t *testing.T) {
+	c, _ := newTestClient(t, pagedTelemetry(10), WithRequestBudget(3))
+
+	all, err := c.GetAllTelemetry(context.Background(), "AAAA-0000")
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("err = %v, want ErrBudgetExceeded", err)
+	}
+	if len(all) != 6 {
+		t.Errorf("got %d records, want the 3 pages fetched within budget", len(all))
+	}
+	if s := c.Stats(); s.Requests != 3 || s.ByEndpoint["/telemetry/"] != 3 {
+		t.Errorf("Stats = %+v, want 3 telemetry requests", s)
+	}
+
+	// A new window lets the client carry on.
+	c.ResetStats()
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Errorf("after ResetStats: %v", err)
+	}
+}
+
+func TestStatsAndResetStats(t *testing.T) {
+	var hits atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, fullPage)
+	}, WithRetries(1), withClock(newFakeClock()))
+
+	if _, err := c.GetTelemetry("AAAA-0000"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetSatellites(SatelliteFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	s := c.Stats()
+	if s.Requests != 3 || s.Retries != 1 || s.BytesDownloaded == 0 {
+		t.Errorf("Stats = %+v, want 3 requests, 1 retry and some bytes", s)
+	}
+	if s.ByEndpoint["/telemetry/"] != 2 || s.ByEndpoint["/satellites/"] != 1 {
+		t.Errorf("ByEndpoint = %v", s.ByEndpoint)
+	}
+
+	c.ResetStats()
+	if s := c.Stats(); s.Requests != 0 || s.Retries != 0 || s.BytesDownloaded != 0 || len(s.ByEndpoint) != 0 {
+		t.Errorf("Stats after ResetStats = %+v, want zeroes", s)
+	}
+}